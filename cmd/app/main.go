@@ -7,18 +7,26 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
 	"sync"
+	"syscall"
 	"time"
 
 	"delivery/cmd"
 	httpin "delivery/internal/adapters/in/http"
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/deadletter"
+	"delivery/internal/adapters/out/postgres/inbox"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outbox"
+	"delivery/internal/adapters/out/postgres/statsrepo"
 	"delivery/internal/core/application/usecases/commands"
-	"delivery/internal/core/domain/services"
+	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -42,26 +50,48 @@ func main() {
 	db := mustGormOpen(dsn)
 	mustAutoMigrate(db)
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	cr := cmd.NewCompositionRoot(cfg, db)
 	defer cr.CloseAll()
-	startJobs(cr, context.TODO()) // TODO: move to closers
-	startWebServer(cr, cfg.HttpPort)
+	startJobs(cr, ctx)
+	startOutboxRelay(cr, ctx)
+	startKafkaConsumers(cr, ctx)
+	startWebServer(cr, ctx, cfg.HttpPort)
 }
 
 func getConfigs() cmd.Config {
 	config := cmd.Config{
-		HttpPort:                  goDotEnvVariable("HTTP_PORT"),
-		DbHost:                    goDotEnvVariable("DB_HOST"),
-		DbPort:                    goDotEnvVariable("DB_PORT"),
-		DbUser:                    goDotEnvVariable("DB_USER"),
-		DbPassword:                goDotEnvVariable("DB_PASSWORD"),
-		DbName:                    goDotEnvVariable("DB_NAME"),
-		DbSslMode:                 goDotEnvVariable("DB_SSLMODE"),
-		GeoServiceGrpcHost:        goDotEnvVariable("GEO_SERVICE_GRPC_HOST"),
-		KafkaHost:                 goDotEnvVariable("KAFKA_HOST"),
-		KafkaConsumerGroup:        goDotEnvVariable("KAFKA_CONSUMER_GROUP"),
-		KafkaBasketConfirmedTopic: goDotEnvVariable("KAFKA_BASKET_CONFIRMED_TOPIC"),
-		KafkaOrderChangedTopic:    goDotEnvVariable("KAFKA_ORDER_CHANGED_TOPIC"),
+		HttpPort:                           goDotEnvVariable("HTTP_PORT"),
+		DbHost:                             goDotEnvVariable("DB_HOST"),
+		DbPort:                             goDotEnvVariable("DB_PORT"),
+		DbUser:                             goDotEnvVariable("DB_USER"),
+		DbPassword:                         goDotEnvVariable("DB_PASSWORD"),
+		DbName:                             goDotEnvVariable("DB_NAME"),
+		DbSslMode:                          goDotEnvVariable("DB_SSLMODE"),
+		GeoServiceGrpcHost:                 goDotEnvVariable("GEO_SERVICE_GRPC_HOST"),
+		KafkaHost:                          goDotEnvVariable("KAFKA_HOST"),
+		KafkaConsumerGroup:                 goDotEnvVariable("KAFKA_CONSUMER_GROUP"),
+		KafkaBasketConfirmedTopic:          goDotEnvVariable("KAFKA_BASKET_CONFIRMED_TOPIC"),
+		KafkaOrderChangedTopic:             goDotEnvVariable("KAFKA_ORDER_CHANGED_TOPIC"),
+		KafkaDeadLetterTopic:               goDotEnvVariable("KAFKA_DEAD_LETTER_TOPIC"),
+		KafkaConfirmationCodeTopic:         goDotEnvVariable("KAFKA_CONFIRMATION_CODE_TOPIC"),
+		DeadLetterMaxAttempts:              goDotEnvInt("DEAD_LETTER_MAX_ATTEMPTS", 5),
+		AreaMinX:                           goDotEnvInt("AREA_MIN_X", kernel.DefaultAreaMin),
+		AreaMinY:                           goDotEnvInt("AREA_MIN_Y", kernel.DefaultAreaMin),
+		AreaMaxX:                           goDotEnvInt("AREA_MAX_X", kernel.DefaultAreaMax),
+		AreaMaxY:                           goDotEnvInt("AREA_MAX_Y", kernel.DefaultAreaMax),
+		CoordinateSystem:                   goDotEnvVariable("COORDINATE_SYSTEM"),
+		WarehouseStreet:                    goDotEnvVariable("WAREHOUSE_STREET"),
+		DeliveryConfirmationRequired:       goDotEnvBool("DELIVERY_CONFIRMATION_REQUIRED", false),
+		DeliveryConfirmationTimeoutSeconds: goDotEnvInt("DELIVERY_CONFIRMATION_TIMEOUT_SECONDS", 300),
+		OutboxSecretKey:                    goDotEnvVariable("OUTBOX_SECRET_KEY"),
+		MaxRedeliveryAttempts:              goDotEnvInt("MAX_REDELIVERY_ATTEMPTS", 2),
+		AreaSouth:                          goDotEnvFloat("AREA_SOUTH", 0),
+		AreaWest:                           goDotEnvFloat("AREA_WEST", 0),
+		AreaNorth:                          goDotEnvFloat("AREA_NORTH", 0),
+		AreaEast:                           goDotEnvFloat("AREA_EAST", 0),
 	}
 	return config
 }
@@ -76,12 +106,63 @@ func goDotEnvVariable(key string) string {
 	return os.Getenv(key)
 }
 
-func startWebServer(compositionRoot *cmd.CompositionRoot, port string) {
+func goDotEnvInt(key string, defaultValue int) int {
+	value := goDotEnvVariable(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("ERROR: parse %s: %v", key, err)
+	}
+	return n
+}
+
+func goDotEnvBool(key string, defaultValue bool) bool {
+	value := goDotEnvVariable(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Fatalf("ERROR: parse %s: %v", key, err)
+	}
+	return b
+}
+
+func goDotEnvFloat(key string, defaultValue float64) float64 {
+	value := goDotEnvVariable(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Fatalf("ERROR: parse %s: %v", key, err)
+	}
+	return f
+}
+
+func startWebServer(compositionRoot *cmd.CompositionRoot, ctx context.Context, port string) {
 	handlers, err := httpin.New(
 		compositionRoot.NewCreateOrderCommandHandler(),
+		compositionRoot.NewCancelOrderCommandHandler(),
+		compositionRoot.NewReassignOrderCommandHandler(),
+		compositionRoot.NewConfirmDeliveryCommandHandler(),
+		compositionRoot.NewFailDeliveryCommandHandler(),
+		compositionRoot.NewRateDeliveryCommandHandler(),
 		compositionRoot.NewCreateCourierCommandHandler(),
+		compositionRoot.NewChangeCourierAvailabilityCommandHandler(),
+		compositionRoot.NewAddStoragePlaceCommandHandler(),
+		compositionRoot.NewRemoveStoragePlaceCommandHandler(),
 		compositionRoot.NewGetAllCouriersQueryHandler(),
+		compositionRoot.NewGetCourierStatsQueryHandler(),
 		compositionRoot.NewGetIncompletedOrdersQueryHandler(),
+		compositionRoot.NewGetDeadLettersQueryHandler(),
+		compositionRoot.NewGetDeadLetterQueryHandler(),
+		compositionRoot.NewReplayDeadLetterCommandHandler(),
 	)
 	if err != nil {
 		log.Fatalf("ERROR: init HTTP Server: %v", err)
@@ -102,18 +183,31 @@ func startWebServer(compositionRoot *cmd.CompositionRoot, port string) {
 	_ = oam.OapiRequestValidator(spec)
 	// e.Use(oam.OapiRequestValidator(spec)) // bug: it will break //docs ang /openapi.json
 	e.Pre(middleware.RemoveTrailingSlash())
-	registerSwaggerOpenApi(e)
+	registerSwaggerOpenApi(e, compositionRoot.NewArea())
 	registerSwaggerUi(e)
 	servers.RegisterHandlers(e, handlers)
-	e.Logger.Fatal(e.Start("0.0.0.0:" + port))
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := e.Shutdown(shutdownCtx); err != nil {
+			log.Printf("ERROR: shutdown HTTP Server: %v", err)
+		}
+	}()
+
+	if err := e.Start("0.0.0.0:" + port); err != nil && err != http.ErrServerClosed {
+		log.Printf("ERROR: HTTP Server: %v", err)
+	}
 }
 
-func registerSwaggerOpenApi(e *echo.Echo) {
+func registerSwaggerOpenApi(e *echo.Echo, area kernel.Area) {
 	e.GET("/openapi.json", func(c echo.Context) error {
 		swagger, err := servers.GetSwagger()
 		if err != nil {
 			return c.String(http.StatusInternalServerError, "failed to load swagger: "+err.Error())
 		}
+		applyAreaToSpec(swagger, area)
 
 		data, err := swagger.MarshalJSON()
 		if err != nil {
@@ -124,6 +218,29 @@ func registerSwaggerOpenApi(e *echo.Echo) {
 	})
 }
 
+// applyAreaToSpec подставляет в схему Location границы настроенной зоны доставки
+func applyAreaToSpec(swagger *openapi3.T, area kernel.Area) {
+	if swagger.Components == nil {
+		return
+	}
+	location := swagger.Components.Schemas["Location"]
+	if location == nil || location.Value == nil {
+		return
+	}
+
+	bounds := map[string][2]int{
+		"x": {area.MinX(), area.MaxX()},
+		"y": {area.MinY(), area.MaxY()},
+	}
+	for name, b := range bounds {
+		prop := location.Value.Properties[name]
+		if prop == nil || prop.Value == nil {
+			continue
+		}
+		prop.Value.WithMin(float64(b[0])).WithMax(float64(b[1]))
+	}
+}
+
 func registerSwaggerUi(e *echo.Echo) {
 	e.GET("/docs", func(c echo.Context) error {
 		html := `
@@ -222,26 +339,88 @@ func mustAutoMigrate(db *gorm.DB) {
 		log.Fatalf("ERROR: automigrate storage place:%v", err)
 	}
 
+	err = db.AutoMigrate(&courierrepo.StoredOrderDTO{})
+	if err != nil {
+		log.Fatalf("ERROR: automigrate stored order: %v", err)
+	}
+
 	err = db.AutoMigrate(&orderrepo.OrderDTO{})
 	if err != nil {
 		log.Fatalf("ERROR: automigrate order: %v", err)
 	}
+
+	err = courierrepo.MigrateTransportTypes(context.Background(), db)
+	if err != nil {
+		log.Fatalf("ERROR: migrate transport types: %v", err)
+	}
+
+	err = courierrepo.MigrateStoredOrders(context.Background(), db)
+	if err != nil {
+		log.Fatalf("ERROR: migrate stored orders: %v", err)
+	}
+
+	err = db.AutoMigrate(&outbox.MessageDTO{})
+	if err != nil {
+		log.Fatalf("ERROR: automigrate outbox: %v", err)
+	}
+
+	err = db.AutoMigrate(&inbox.MessageDTO{})
+	if err != nil {
+		log.Fatalf("ERROR: automigrate inbox: %v", err)
+	}
+
+	err = db.AutoMigrate(&deadletter.DeadLetterDTO{})
+	if err != nil {
+		log.Fatalf("ERROR: automigrate dead letters: %v", err)
+	}
+
+	err = db.AutoMigrate(&statsrepo.CourierStatsDTO{})
+	if err != nil {
+		log.Fatalf("ERROR: automigrate courier stats: %v", err)
+	}
+
+	err = db.AutoMigrate(&statsrepo.RatingDTO{})
+	if err != nil {
+		log.Fatalf("ERROR: automigrate courier ratings: %v", err)
+	}
+
+	err = db.AutoMigrate(&statsrepo.AppliedEventDTO{})
+	if err != nil {
+		log.Fatalf("ERROR: automigrate courier stats events: %v", err)
+	}
+}
+
+func startKafkaConsumers(cr *cmd.CompositionRoot, ctx context.Context) {
+	basketConfirmedConsumer := cr.NewBasketConfirmedConsumer()
+	go func() {
+		if err := basketConfirmedConsumer.Consume(ctx); err != nil {
+			log.Printf("ERROR: consume basket confirmed: %v", err)
+		}
+	}()
 }
 
 func startJobs(cr *cmd.CompositionRoot, ctx context.Context) {
 	assignOrdersCommandHandler, err := commands.NewAssignOrderCommandHandler(
 		cr.NewUnitOfWorkFactory(),
-		services.NewOrderDispatcher(),
+		cr.NewOrderDispatcherService(),
+		cr.NewCourierStatsRepository(),
 	)
 	if err != nil {
 		log.Fatalf("ERROR: create assignOrdersCommandHandler: %v", err)
 	}
 
-	moveCouriersCommandHandler, err := commands.NewMoveCouriersCommandHandler(cr.NewUnitOfWorkFactory())
+	moveCouriersCommandHandler, err := commands.NewMoveCouriersCommandHandler(
+		cr.NewUnitOfWorkFactory(),
+		cr.NewRoutePlannerService(),
+		cr.NewArea(),
+	)
 	if err != nil {
 		log.Fatalf("ERROR: create assignOrdersCommandHandler: %v", err)
 	}
 
+	flagOverdueConfirmationsCommandHandler := cr.NewFlagOverdueConfirmationsCommandHandler()
+	retryFailedDeliveriesCommandHandler := cr.NewRetryFailedDeliveriesCommandHandler()
+
 	ch := time.Tick(time.Second)
 	go func() {
 		for {
@@ -267,6 +446,54 @@ func startJobs(cr *cmd.CompositionRoot, ctx context.Context) {
 				if err != nil {
 					log.Printf("ERROR: handle command MoveCouriersCommand: %v", err)
 				}
+				// flag unconfirmed deliveries
+				flagOverdueCommand, err := commands.NewFlagOverdueConfirmationsCommand()
+				if err != nil {
+					log.Printf("ERROR: make command FlagOverdueConfirmationsCommand: %v", err)
+				}
+				err = flagOverdueConfirmationsCommandHandler.Handle(ctx, flagOverdueCommand)
+				if err != nil {
+					log.Printf("ERROR: handle command FlagOverdueConfirmationsCommand: %v", err)
+				}
+				// redeliver or return failed orders
+				retryFailedCommand, err := commands.NewRetryFailedDeliveriesCommand()
+				if err != nil {
+					log.Printf("ERROR: make command RetryFailedDeliveriesCommand: %v", err)
+				}
+				err = retryFailedDeliveriesCommandHandler.Handle(ctx, retryFailedCommand)
+				if err != nil {
+					log.Printf("ERROR: handle command RetryFailedDeliveriesCommand: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func startOutboxRelay(cr *cmd.CompositionRoot, ctx context.Context) {
+	const (
+		purgeInterval   = time.Hour
+		outboxRetention = 7 * 24 * time.Hour
+	)
+
+	relay := cr.NewOutboxRelay()
+
+	processTicker := time.NewTicker(time.Second)
+	purgeTicker := time.NewTicker(purgeInterval)
+	go func() {
+		defer processTicker.Stop()
+		defer purgeTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-processTicker.C:
+				if err := relay.Process(ctx); err != nil {
+					log.Printf("ERROR: process outbox: %v", err)
+				}
+			case <-purgeTicker.C:
+				if err := relay.Purge(ctx, outboxRetention); err != nil {
+					log.Printf("ERROR: purge outbox: %v", err)
+				}
 			}
 		}
 	}()
@@ -3,14 +3,26 @@ package cmd
 import (
 	"io"
 	"log"
+	"strings"
 	"sync"
+	"time"
 
+	kafkain "delivery/internal/adapters/in/kafka"
 	grpcout "delivery/internal/adapters/out/grpc"
+	kafkaout "delivery/internal/adapters/out/kafka"
 	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/deadletter"
+	"delivery/internal/adapters/out/postgres/outbox"
+	"delivery/internal/adapters/out/postgres/statsrepo"
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/application/usecases/eventhandlers"
 	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
 
 	"gorm.io/gorm"
 )
@@ -20,6 +32,12 @@ type CompositionRoot struct {
 	db        *gorm.DB
 	geoClient ports.GeoClient
 	onceGeo   sync.Once
+	registry  *outbox.EventRegistry
+	onceReg   sync.Once
+	mediatr   ddd.Mediatr
+	onceMed   sync.Once
+	consumer  *kafkain.BasketConfirmedConsumer
+	onceCons  sync.Once
 	//
 	closers []io.Closer
 }
@@ -29,11 +47,15 @@ func NewCompositionRoot(cfg Config, db *gorm.DB) *CompositionRoot {
 }
 
 func (c *CompositionRoot) NewOrderDispatcherService() services.OrderDispatcher {
-	return services.NewOrderDispatcher()
+	return services.NewOrderDispatcher(c.NewArea())
+}
+
+func (c *CompositionRoot) NewRoutePlannerService() services.RoutePlanner {
+	return services.NewRoutePlanner(c.NewArea())
 }
 
 func (c *CompositionRoot) NewUnitOfWorkFactory() ports.UnitOfWorkFactory {
-	factory, err := postgres.NewUnitOfWorkFactory(c.db)
+	factory, err := postgres.NewUnitOfWorkFactoryWithCipher(c.db, c.NewMediatr(), c.NewOutboxCipher())
 	if err != nil {
 		log.Fatalf("new unit of work factory: %v", err)
 	}
@@ -41,21 +63,110 @@ func (c *CompositionRoot) NewUnitOfWorkFactory() ports.UnitOfWorkFactory {
 }
 
 func (c *CompositionRoot) NewCreateOrderCommandHandler() commands.CreateOrderCommandHandler {
-	h, err := commands.NewCreateOrderCommandHandler(c.NewUnitOfWorkFactory(), c.NewGeoClient())
+	h, err := commands.NewCreateOrderCommandHandler(
+		c.NewUnitOfWorkFactory(),
+		c.NewGeoClient(),
+		c.config.WarehouseStreet,
+		c.config.DeliveryConfirmationRequired,
+	)
 	if err != nil {
 		log.Fatalf("ERROR: cannot create CreateOrderCommandHandler: %v", err)
 	}
 	return h
 }
 
+func (c *CompositionRoot) NewConfirmDeliveryCommandHandler() commands.ConfirmDeliveryCommandHandler {
+	h, err := commands.NewConfirmDeliveryCommandHandler(c.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("ERROR: cannot create ConfirmDeliveryCommandHandler: %v", err)
+	}
+	return h
+}
+
+func (c *CompositionRoot) NewFlagOverdueConfirmationsCommandHandler() commands.FlagOverdueConfirmationsCommandHandler {
+	h, err := commands.NewFlagOverdueConfirmationsCommandHandler(
+		c.NewUnitOfWorkFactory(),
+		time.Duration(c.config.DeliveryConfirmationTimeoutSeconds)*time.Second,
+		time.Now,
+	)
+	if err != nil {
+		log.Fatalf("ERROR: cannot create FlagOverdueConfirmationsCommandHandler: %v", err)
+	}
+	return h
+}
+
+func (c *CompositionRoot) NewFailDeliveryCommandHandler() commands.FailDeliveryCommandHandler {
+	h, err := commands.NewFailDeliveryCommandHandler(c.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("ERROR: cannot create FailDeliveryCommandHandler: %v", err)
+	}
+	return h
+}
+
+func (c *CompositionRoot) NewRetryFailedDeliveriesCommandHandler() commands.RetryFailedDeliveriesCommandHandler {
+	h, err := commands.NewRetryFailedDeliveriesCommandHandler(c.NewUnitOfWorkFactory(), c.config.MaxRedeliveryAttempts)
+	if err != nil {
+		log.Fatalf("ERROR: cannot create RetryFailedDeliveriesCommandHandler: %v", err)
+	}
+	return h
+}
+
+func (c *CompositionRoot) NewRateDeliveryCommandHandler() commands.RateDeliveryCommandHandler {
+	h, err := commands.NewRateDeliveryCommandHandler(c.NewUnitOfWorkFactory(), c.NewCourierStatsRepository())
+	if err != nil {
+		log.Fatalf("ERROR: cannot create RateDeliveryCommandHandler: %v", err)
+	}
+	return h
+}
+
 func (c *CompositionRoot) NewCreateCourierCommandHandler() commands.CreateCourierCommandHandler {
-	h, err := commands.NewCreateCourierCommandHandler(c.NewUnitOfWorkFactory())
+	h, err := commands.NewCreateCourierCommandHandler(c.NewUnitOfWorkFactory(), c.NewArea())
 	if err != nil {
 		log.Fatalf("ERROR: cannot create CreateCourierCommandHandler: %v", err)
 	}
 	return h
 }
 
+func (c *CompositionRoot) NewAddStoragePlaceCommandHandler() commands.AddStoragePlaceCommandHandler {
+	h, err := commands.NewAddStoragePlaceCommandHandler(c.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("ERROR: cannot create AddStoragePlaceCommandHandler: %v", err)
+	}
+	return h
+}
+
+func (c *CompositionRoot) NewRemoveStoragePlaceCommandHandler() commands.RemoveStoragePlaceCommandHandler {
+	h, err := commands.NewRemoveStoragePlaceCommandHandler(c.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("ERROR: cannot create RemoveStoragePlaceCommandHandler: %v", err)
+	}
+	return h
+}
+
+func (c *CompositionRoot) NewCancelOrderCommandHandler() commands.CancelOrderCommandHandler {
+	h, err := commands.NewCancelOrderCommandHandler(c.NewUnitOfWorkFactory(), services.NewOrderCanceller())
+	if err != nil {
+		log.Fatalf("ERROR: cannot create CancelOrderCommandHandler: %v", err)
+	}
+	return h
+}
+
+func (c *CompositionRoot) NewReassignOrderCommandHandler() commands.ReassignOrderCommandHandler {
+	h, err := commands.NewReassignOrderCommandHandler(c.NewUnitOfWorkFactory(), services.NewOrderReassigner())
+	if err != nil {
+		log.Fatalf("ERROR: cannot create ReassignOrderCommandHandler: %v", err)
+	}
+	return h
+}
+
+func (c *CompositionRoot) NewChangeCourierAvailabilityCommandHandler() commands.ChangeCourierAvailabilityCommandHandler {
+	h, err := commands.NewChangeCourierAvailabilityCommandHandler(c.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("ERROR: cannot create ChangeCourierAvailabilityCommandHandler: %v", err)
+	}
+	return h
+}
+
 func (c *CompositionRoot) NewGetAllCouriersQueryHandler() queries.GetAllCouriersQueryHandler {
 	h, err := queries.NewGetAllCouriersQueryHandler(c.db)
 	if err != nil {
@@ -64,6 +175,14 @@ func (c *CompositionRoot) NewGetAllCouriersQueryHandler() queries.GetAllCouriers
 	return h
 }
 
+func (c *CompositionRoot) NewGetCourierStatsQueryHandler() queries.GetCourierStatsQueryHandler {
+	h, err := queries.NewGetCourierStatsQueryHandler(c.db)
+	if err != nil {
+		log.Fatalf("ERROR: cannot create GetCourierStatsQueryHandler: %v", err)
+	}
+	return h
+}
+
 func (c *CompositionRoot) NewGetIncompletedOrdersQueryHandler() queries.GetIncompleteOrdersQueryHandler {
 	h, err := queries.NewGetIncompleteOrdersHandler(c.db)
 	if err != nil {
@@ -72,9 +191,221 @@ func (c *CompositionRoot) NewGetIncompletedOrdersQueryHandler() queries.GetIncom
 	return h
 }
 
+func (cr *CompositionRoot) NewBasketConfirmedConsumer() *kafkain.BasketConfirmedConsumer {
+	cr.onceCons.Do(func() {
+		consumer, err := kafkain.NewBasketConfirmedConsumer(
+			strings.Split(cr.config.KafkaHost, ","),
+			cr.config.KafkaConsumerGroup,
+			cr.config.KafkaBasketConfirmedTopic,
+			cr.NewCreateOrderCommandHandler(),
+			cr.NewDeadLetterPolicy(),
+		)
+		if err != nil {
+			log.Fatalf("ERROR: create BasketConfirmedConsumer: %v", err)
+		}
+		cr.RegisterCloser(consumer)
+		cr.consumer = consumer
+	})
+	return cr.consumer
+}
+
+func (cr *CompositionRoot) NewDeadLetterRepository() ports.DeadLetterRepository {
+	repository, err := deadletter.NewRepository(cr.db)
+	if err != nil {
+		log.Fatalf("ERROR: create DeadLetterRepository: %v", err)
+	}
+	return repository
+}
+
+func (cr *CompositionRoot) NewCourierStatsRepository() ports.CourierStatsRepository {
+	repository, err := statsrepo.NewRepository(cr.db)
+	if err != nil {
+		log.Fatalf("ERROR: create CourierStatsRepository: %v", err)
+	}
+	return repository
+}
+
+func (cr *CompositionRoot) NewDeadLetterPolicy() kafkain.DeadLetterPolicy {
+	policy := kafkain.DeadLetterPolicy{
+		MaxAttempts: cr.config.DeadLetterMaxAttempts,
+		Repository:  cr.NewDeadLetterRepository(),
+	}
+
+	// DLQ топик опционален
+	if cr.config.KafkaDeadLetterTopic != "" {
+		producer, err := kafkaout.NewDeadLetterProducer(
+			strings.Split(cr.config.KafkaHost, ","),
+			cr.config.KafkaDeadLetterTopic,
+		)
+		if err != nil {
+			log.Fatalf("ERROR: create DeadLetterProducer: %v", err)
+		}
+		cr.RegisterCloser(producer)
+		policy.Publisher = producer
+	}
+	return policy
+}
+
+func (cr *CompositionRoot) NewGetDeadLettersQueryHandler() queries.GetDeadLettersQueryHandler {
+	h, err := queries.NewGetDeadLettersQueryHandler(cr.db)
+	if err != nil {
+		log.Fatalf("ERROR: cannot create GetDeadLettersQueryHandler: %v", err)
+	}
+	return h
+}
+
+func (cr *CompositionRoot) NewGetDeadLetterQueryHandler() queries.GetDeadLetterQueryHandler {
+	h, err := queries.NewGetDeadLetterQueryHandler(cr.db)
+	if err != nil {
+		log.Fatalf("ERROR: cannot create GetDeadLetterQueryHandler: %v", err)
+	}
+	return h
+}
+
+func (cr *CompositionRoot) NewReplayDeadLetterCommandHandler() commands.ReplayDeadLetterCommandHandler {
+	h, err := commands.NewReplayDeadLetterCommandHandler(
+		cr.NewDeadLetterRepository(),
+		cr.NewBasketConfirmedConsumer(),
+	)
+	if err != nil {
+		log.Fatalf("ERROR: cannot create ReplayDeadLetterCommandHandler: %v", err)
+	}
+	return h
+}
+
+// NewMediatr returns the in-process event bus with all application event handlers subscribed.
+func (cr *CompositionRoot) NewMediatr() ddd.Mediatr {
+	cr.onceMed.Do(func() {
+		cr.mediatr = ddd.NewMediatr()
+		cr.mediatr.Subscribe(
+			eventhandlers.NewOrderStatusChangedHandler(),
+			eventhandlers.OrderStatusChangedEvents()...,
+		)
+		cr.mediatr.Subscribe(eventhandlers.NewOrderSLABreachHandler(), order.OrderSLABreachPredicted{})
+	})
+	return cr.mediatr
+}
+
+func (cr *CompositionRoot) NewEventRegistry() *outbox.EventRegistry {
+	cr.onceReg.Do(func() {
+		cr.registry = outbox.NewEventRegistry()
+		cr.registry.Register(
+			order.OrderCreated{},
+			order.OrderAssigned{},
+			order.OrderPickedUp{},
+			order.OrderAwaitingConfirmation{},
+			order.OrderConfirmationCodeIssued{},
+			order.OrderConfirmationOverdue{},
+			order.OrderCompleted{},
+			order.OrderCancelled{},
+			order.OrderUnassigned{},
+			order.OrderDeliveryFailed{},
+			order.OrderRedeliveryScheduled{},
+			order.OrderReturnedToSender{},
+			order.OrderSLABreachPredicted{},
+			courier.CourierMoved{},
+			courier.CourierTookOrder{},
+			courier.CourierCompletedOrder{},
+			courier.CourierReleasedOrder{},
+			courier.StoragePlaceAdded{},
+			courier.StoragePlaceRemoved{},
+			courier.CourierAvailabilityChanged{},
+		)
+	})
+	return cr.registry
+}
+
+func (cr *CompositionRoot) NewConfirmationCodeSender() ports.ConfirmationCodeSender {
+	producer, err := kafkaout.NewConfirmationCodeProducer(
+		strings.Split(cr.config.KafkaHost, ","),
+		cr.config.KafkaConfirmationCodeTopic,
+	)
+	if err != nil {
+		log.Fatalf("ERROR: create ConfirmationCodeProducer: %v", err)
+	}
+	cr.RegisterCloser(producer)
+	return producer
+}
+
+func (cr *CompositionRoot) NewEventPublisher() ports.EventPublisher {
+	producer, err := kafkaout.NewOrderStatusChangedProducer(
+		strings.Split(cr.config.KafkaHost, ","),
+		cr.config.KafkaOrderChangedTopic,
+	)
+	if err != nil {
+		log.Fatalf("ERROR: create OrderStatusChangedProducer: %v", err)
+	}
+	cr.RegisterCloser(producer)
+	return producer
+}
+
+// NewOutboxRelay publishes committed events to the courier stats, confirmation codes and Kafka.
+// They are fed from the outbox, so neither a stats increment nor a code is lost when the
+// database or Kafka fails after commit.
+func (cr *CompositionRoot) NewOutboxRelay() *outbox.Relay {
+	handlers := ddd.NewMediatr()
+	statsHandler, err := eventhandlers.NewCourierStatsHandler(cr.NewCourierStatsRepository(), cr.NewArea())
+	if err != nil {
+		log.Fatalf("ERROR: create CourierStatsHandler: %v", err)
+	}
+	handlers.Subscribe(statsHandler, eventhandlers.CourierStatsEvents()...)
+	confirmationHandler, err := eventhandlers.NewOrderConfirmationHandler(cr.NewConfirmationCodeSender())
+	if err != nil {
+		log.Fatalf("ERROR: create OrderConfirmationHandler: %v", err)
+	}
+	handlers.Subscribe(confirmationHandler, order.OrderConfirmationCodeIssued{}, order.OrderConfirmationOverdue{})
+
+	relay, err := outbox.NewRelayWithCipher(cr.db, cr.NewEventRegistry(), cr.NewOutboxCipher(), handlers, cr.NewEventPublisher())
+	if err != nil {
+		log.Fatalf("ERROR: create outbox Relay: %v", err)
+	}
+	return relay
+}
+
+// NewOutboxCipher returns nil without a key, then orders requiring confirmation cannot be saved.
+func (cr *CompositionRoot) NewOutboxCipher() *outbox.Cipher {
+	if cr.config.OutboxSecretKey == "" {
+		if cr.config.DeliveryConfirmationRequired {
+			log.Fatalf("ERROR: OUTBOX_SECRET_KEY is required for delivery confirmation")
+		}
+		return nil
+	}
+
+	cipher, err := outbox.NewCipher(cr.config.OutboxSecretKey)
+	if err != nil {
+		log.Fatalf("ERROR: create outbox Cipher: %v", err)
+	}
+	return cipher
+}
+
+func (cr *CompositionRoot) NewArea() kernel.Area {
+	area, err := kernel.NewArea(cr.config.AreaMinX, cr.config.AreaMinY, cr.config.AreaMaxX, cr.config.AreaMaxY)
+	if err != nil {
+		log.Fatalf("ERROR: create delivery Area: %v", err)
+	}
+
+	switch cr.config.CoordinateSystem {
+	case "", CoordinateSystemGrid:
+		return area
+	case CoordinateSystemGeo:
+		bounds, err := kernel.NewGeoBounds(cr.config.AreaSouth, cr.config.AreaWest, cr.config.AreaNorth, cr.config.AreaEast)
+		if err != nil {
+			log.Fatalf("ERROR: create delivery Area bounds: %v", err)
+		}
+		area, err = area.WithGeoBounds(bounds)
+		if err != nil {
+			log.Fatalf("ERROR: create delivery Area: %v", err)
+		}
+		return area
+	default:
+		log.Fatalf("ERROR: unknown coordinate system %q", cr.config.CoordinateSystem)
+		return kernel.Area{}
+	}
+}
+
 func (cr *CompositionRoot) NewGeoClient() ports.GeoClient {
 	cr.onceGeo.Do(func() {
-		client, err := grpcout.NewClient(cr.config.GeoServiceGrpcHost)
+		client, err := grpcout.NewClient(cr.config.GeoServiceGrpcHost, cr.NewArea())
 		if err != nil {
 			log.Fatalf("ERROR: create GeoClient: %v", err)
 		}
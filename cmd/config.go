@@ -1,5 +1,10 @@
 package cmd
 
+const (
+	CoordinateSystemGrid = "grid"
+	CoordinateSystemGeo  = "geo"
+)
+
 type Config struct {
 	HttpPort                  string
 	DbHost                    string
@@ -13,4 +18,25 @@ type Config struct {
 	KafkaConsumerGroup        string
 	KafkaBasketConfirmedTopic string
 	KafkaOrderChangedTopic    string
+	KafkaDeadLetterTopic      string
+	// Топик, из которого сервис уведомлений отправляет клиенту код подтверждения доставки
+	KafkaConfirmationCodeTopic string
+	DeadLetterMaxAttempts      int
+	AreaMinX                   int
+	AreaMinY                   int
+	AreaMaxX                   int
+	AreaMaxY                   int
+	CoordinateSystem           string
+	WarehouseStreet            string
+	// Подтверждение доставки кодом клиента
+	DeliveryConfirmationRequired       bool
+	DeliveryConfirmationTimeoutSeconds int
+	// Ключ шифрования секретов событий в outbox (код подтверждения), hex 32 байта
+	OutboxSecretKey string
+	AreaSouth       float64
+	AreaWest        float64
+	AreaNorth       float64
+	AreaEast        float64
+	// Сколько раз неврученный заказ возвращается в диспетчеризацию до возврата отправителю
+	MaxRedeliveryAttempts int
 }
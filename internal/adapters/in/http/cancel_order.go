@@ -0,0 +1,30 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) CancelOrder(c echo.Context, orderId uuid.UUID) error {
+	cmd, err := commands.NewCancelOrderCommand(orderId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.cancelOrder.Handle(c.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) SetCourierOnline(c echo.Context, courierId uuid.UUID) error {
+	return s.changeCourierAvailability(c, courierId, courier.AvailabilityOnline)
+}
+
+func (s *Server) SetCourierOffline(c echo.Context, courierId uuid.UUID) error {
+	return s.changeCourierAvailability(c, courierId, courier.AvailabilityOffline)
+}
+
+func (s *Server) StartCourierBreak(c echo.Context, courierId uuid.UUID) error {
+	return s.changeCourierAvailability(c, courierId, courier.AvailabilityOnBreak)
+}
+
+func (s *Server) changeCourierAvailability(c echo.Context, courierId uuid.UUID, availability courier.Availability) error {
+	cmd, err := commands.NewChangeCourierAvailabilityCommand(courierId, availability)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.changeAvailability.Handle(c.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"delivery/internal/adapters/in/http/problems"
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
 	"errors"
@@ -12,12 +13,18 @@ import (
 )
 
 func (s *Server) CreateCourier(c echo.Context) error {
-	var courier servers.NewCourier
-	if err := c.Bind(&courier); err != nil {
+	var body servers.NewCourier
+	if err := c.Bind(&body); err != nil {
 		return problems.NewBadRequest("invalid JSON body: " + err.Error())
 	}
 
-	cmd, err := commands.NewCreateCourierCommand(courier.Name, courier.Speed)
+	// Без вида транспорта курьер создается по скорости, как до появления транспорта
+	var transport courier.TransportType
+	if body.TransportType != nil {
+		transport = courier.TransportType(*body.TransportType)
+	}
+
+	cmd, err := commands.NewCreateCourierCommand(body.Name, transport, body.Speed)
 	if err != nil {
 		return problems.NewBadRequest(err.Error())
 	}
@@ -6,6 +6,8 @@ import (
 
 	"delivery/internal/adapters/in/http/problems"
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
@@ -13,7 +15,28 @@ import (
 )
 
 func (s *Server) CreateOrder(c echo.Context) error {
-	cmd, err := commands.NewCreateOrderCommand(uuid.New(), "TestOrder", 5)
+	var body servers.NewOrder
+	if err := c.Bind(&body); err != nil {
+		return problems.NewBadRequest("invalid JSON body: " + err.Error())
+	}
+	pickupStreet := ""
+	if body.PickupStreet != nil {
+		pickupStreet = *body.PickupStreet
+	}
+	weight := 0
+	if body.Weight != nil {
+		weight = *body.Weight
+	}
+	handling := order.HandlingAmbient
+	if body.Handling != nil {
+		handling = order.Handling(*body.Handling)
+	}
+	priority := order.PriorityStandard
+	if body.Priority != nil {
+		priority = order.Priority(*body.Priority)
+	}
+
+	cmd, err := commands.NewCreateOrderCommand(uuid.New(), "TestOrder", pickupStreet, 5, weight, handling, priority, body.PromisedBy)
 	if err != nil {
 		return problems.NewBadRequest(err.Error())
 	}
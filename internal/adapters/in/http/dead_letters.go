@@ -0,0 +1,83 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetDeadLetters(c echo.Context) error {
+	query, err := queries.NewGetDeadLettersQuery()
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	queryResponse, err := s.getDeadLetters.Handle(c.Request().Context(), query)
+	if err != nil {
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	httpResponse := make([]servers.DeadLetter, 0, len(queryResponse.DeadLetters))
+	for _, deadLetter := range queryResponse.DeadLetters {
+		httpResponse = append(httpResponse, deadLetterToResponse(deadLetter))
+	}
+	return c.JSON(http.StatusOK, httpResponse)
+}
+
+func (s *Server) GetDeadLetter(c echo.Context, deadLetterId uuid.UUID) error {
+	query, err := queries.NewGetDeadLetterQuery(deadLetterId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	queryResponse, err := s.getDeadLetter.Handle(c.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	httpResponse := deadLetterToResponse(queryResponse.DeadLetter)
+	payload := string(queryResponse.Payload)
+	httpResponse.Payload = &payload
+	return c.JSON(http.StatusOK, httpResponse)
+}
+
+func (s *Server) ReplayDeadLetter(c echo.Context, deadLetterId uuid.UUID) error {
+	cmd, err := commands.NewReplayDeadLetterCommand(deadLetterId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.replayDeadLetter.Handle(c.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
+
+func deadLetterToResponse(deadLetter queries.DeadLetter) servers.DeadLetter {
+	return servers.DeadLetter{
+		Id:         deadLetter.ID,
+		MessageId:  deadLetter.MessageID,
+		Source:     deadLetter.Source,
+		ErrorKind:  deadLetter.ErrorKind,
+		Error:      deadLetter.ErrorMessage,
+		Attempts:   deadLetter.Attempts,
+		CreatedAt:  deadLetter.CreatedAtUtc,
+		ReplayedAt: deadLetter.ReplayedAtUtc,
+	}
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetCourierStats(c echo.Context, courierId uuid.UUID) error {
+	query, err := queries.NewGetCourierStatsQuery(courierId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	queryResponse, err := s.getCourierStats.Handle(c.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	httpResponse := servers.CourierStats{
+		CourierId:                  queryResponse.CourierID,
+		DeliveredOrders:            queryResponse.DeliveredOrders,
+		Distance:                   queryResponse.Distance,
+		AverageDeliveryTimeSeconds: int(queryResponse.AverageDeliveryTime.Seconds()),
+		Rating:                     queryResponse.Rating,
+		RatingCount:                queryResponse.RatingCount,
+	}
+	return c.JSON(http.StatusOK, httpResponse)
+}
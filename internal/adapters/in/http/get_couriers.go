@@ -9,6 +9,7 @@ import (
 	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
@@ -27,15 +28,46 @@ func (s *Server) GetCouriers(c echo.Context) error {
 
 	httpResponse := make([]servers.Courier, 0, len(queryResponse.Couriers))
 	for _, courier := range queryResponse.Couriers {
-		location := servers.Location{
-			X: courier.Location.X,
-			Y: courier.Location.Y,
+		location := toHttpLocation(courier.Location)
+
+		route := make([]servers.RouteStop, 0, len(courier.Route))
+		for _, stop := range courier.Route {
+			kind := servers.Dropoff
+			if stop.Kind != "" {
+				kind = servers.RouteStopKind(stop.Kind)
+			}
+			route = append(route, servers.RouteStop{
+				OrderId:  stop.OrderID,
+				Kind:     &kind,
+				Location: toHttpLocation(stop.Location),
+			})
+		}
+
+		storagePlaces := make([]servers.StoragePlace, 0, len(courier.StoragePlaces))
+		for _, place := range courier.StoragePlaces {
+			orderIDs := place.OrderIDs
+			if orderIDs == nil {
+				orderIDs = []uuid.UUID{}
+			}
+			storagePlaces = append(storagePlaces, servers.StoragePlace{
+				Id:             place.ID,
+				Name:           place.Name,
+				Kind:           servers.StoragePlaceKind(place.Kind),
+				TotalVolume:    place.TotalVolume,
+				OccupiedVolume: place.OccupiedVolume,
+				MaxWeight:      &place.MaxWeight,
+				OrderIds:       &orderIDs,
+			})
 		}
 
 		courier := servers.Courier{
-			Id:       courier.ID,
-			Name:     courier.Name,
-			Location: location,
+			Id:            courier.ID,
+			Name:          courier.Name,
+			Location:      location,
+			Availability:  servers.CourierAvailability(courier.Availability),
+			TransportType: servers.TransportType(courier.TransportType),
+			Route:         &route,
+			StoragePlaces: &storagePlaces,
 		}
 		httpResponse = append(httpResponse, courier)
 	}
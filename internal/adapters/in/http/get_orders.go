@@ -26,17 +26,30 @@ func (s *Server) GetOrders(c echo.Context) error {
 	}
 
 	httpResponse := make([]servers.Order, 0, len(queryResponse.Orders))
-	for _, courier := range queryResponse.Orders {
-		location := servers.Location{
-			X: courier.Location.X,
-			Y: courier.Location.Y,
-		}
+	for _, item := range queryResponse.Orders {
+		location := toHttpLocation(item.Location)
 
-		courier := servers.Order{
-			Id:       courier.ID,
+		status := servers.OrderStatus(item.Status)
+		order := servers.Order{
+			Id:       item.ID,
 			Location: location,
+			Status:   &status,
+		}
+		if item.FailureReason != "" {
+			reason := servers.FailureReason(item.FailureReason)
+			order.FailureReason = &reason
+			order.FailedAttempts = &item.FailedAttempts
+		}
+		if item.Pickup.X != nil && item.Pickup.Y != nil {
+			pickup := toHttpLocation(queries.Location{
+				X:   *item.Pickup.X,
+				Y:   *item.Pickup.Y,
+				Lat: item.Pickup.Lat,
+				Lon: item.Pickup.Lon,
+			})
+			order.Pickup = &pickup
 		}
-		httpResponse = append(httpResponse, courier)
+		httpResponse = append(httpResponse, order)
 	}
 	return c.JSON(http.StatusOK, httpResponse)
 }
@@ -0,0 +1,15 @@
+package http
+
+import (
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/servers"
+)
+
+func toHttpLocation(location queries.Location) servers.Location {
+	return servers.Location{
+		X:   location.X,
+		Y:   location.Y,
+		Lat: location.Lat,
+		Lon: location.Lon,
+	}
+}
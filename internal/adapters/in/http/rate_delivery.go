@@ -0,0 +1,36 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) RateDelivery(c echo.Context, orderId uuid.UUID) error {
+	var body servers.DeliveryRating
+	if err := c.Bind(&body); err != nil {
+		return problems.NewBadRequest("invalid JSON body: " + err.Error())
+	}
+
+	cmd, err := commands.NewRateDeliveryCommand(orderId, body.Score)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.rateDelivery.Handle(c.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -11,37 +11,121 @@ var _ servers.ServerInterface = (*Server)(nil)
 
 type Server struct {
 	createOrder          commands.CreateOrderCommandHandler
+	cancelOrder          commands.CancelOrderCommandHandler
+	reassignOrder        commands.ReassignOrderCommandHandler
+	confirmDelivery      commands.ConfirmDeliveryCommandHandler
+	failDelivery         commands.FailDeliveryCommandHandler
+	rateDelivery         commands.RateDeliveryCommandHandler
 	createCourier        commands.CreateCourierCommandHandler
+	changeAvailability   commands.ChangeCourierAvailabilityCommandHandler
+	addStoragePlace      commands.AddStoragePlaceCommandHandler
+	removeStoragePlace   commands.RemoveStoragePlaceCommandHandler
 	getAllCouriers       queries.GetAllCouriersQueryHandler
+	getCourierStats      queries.GetCourierStatsQueryHandler
 	getIncompletedOrders queries.GetIncompleteOrdersQueryHandler
+	getDeadLetters       queries.GetDeadLettersQueryHandler
+	getDeadLetter        queries.GetDeadLetterQueryHandler
+	replayDeadLetter     commands.ReplayDeadLetterCommandHandler
 }
 
 func New(
 	createOrder commands.CreateOrderCommandHandler,
+	cancelOrder commands.CancelOrderCommandHandler,
+	reassignOrder commands.ReassignOrderCommandHandler,
+	confirmDelivery commands.ConfirmDeliveryCommandHandler,
+	failDelivery commands.FailDeliveryCommandHandler,
+	rateDelivery commands.RateDeliveryCommandHandler,
 	createCourier commands.CreateCourierCommandHandler,
+	changeAvailability commands.ChangeCourierAvailabilityCommandHandler,
+	addStoragePlace commands.AddStoragePlaceCommandHandler,
+	removeStoragePlace commands.RemoveStoragePlaceCommandHandler,
 	getAllCouriers queries.GetAllCouriersQueryHandler,
+	getCourierStats queries.GetCourierStatsQueryHandler,
 	getIncompletedOrders queries.GetIncompleteOrdersQueryHandler,
+	getDeadLetters queries.GetDeadLettersQueryHandler,
+	getDeadLetter queries.GetDeadLetterQueryHandler,
+	replayDeadLetter commands.ReplayDeadLetterCommandHandler,
 ) (*Server, error) {
 	if createOrder == nil {
 		return nil, errs.NewValueIsRequiredError("createOrder")
 	}
 
+	if cancelOrder == nil {
+		return nil, errs.NewValueIsRequiredError("cancelOrder")
+	}
+
+	if reassignOrder == nil {
+		return nil, errs.NewValueIsRequiredError("reassignOrder")
+	}
+
+	if confirmDelivery == nil {
+		return nil, errs.NewValueIsRequiredError("confirmDelivery")
+	}
+
+	if failDelivery == nil {
+		return nil, errs.NewValueIsRequiredError("failDelivery")
+	}
+
+	if rateDelivery == nil {
+		return nil, errs.NewValueIsRequiredError("rateDelivery")
+	}
+
 	if createCourier == nil {
 		return nil, errs.NewValueIsRequiredError("createCourier")
 	}
 
+	if changeAvailability == nil {
+		return nil, errs.NewValueIsRequiredError("changeAvailability")
+	}
+
+	if addStoragePlace == nil {
+		return nil, errs.NewValueIsRequiredError("addStoragePlace")
+	}
+
+	if removeStoragePlace == nil {
+		return nil, errs.NewValueIsRequiredError("removeStoragePlace")
+	}
+
 	if getAllCouriers == nil {
 		return nil, errs.NewValueIsRequiredError("getAllCouriers")
 	}
 
+	if getCourierStats == nil {
+		return nil, errs.NewValueIsRequiredError("getCourierStats")
+	}
+
 	if getIncompletedOrders == nil {
 		return nil, errs.NewValueIsRequiredError("getIncompletedOrders")
 	}
 
+	if getDeadLetters == nil {
+		return nil, errs.NewValueIsRequiredError("getDeadLetters")
+	}
+
+	if getDeadLetter == nil {
+		return nil, errs.NewValueIsRequiredError("getDeadLetter")
+	}
+
+	if replayDeadLetter == nil {
+		return nil, errs.NewValueIsRequiredError("replayDeadLetter")
+	}
+
 	return &Server{
 		createOrder:          createOrder,
+		cancelOrder:          cancelOrder,
+		reassignOrder:        reassignOrder,
+		confirmDelivery:      confirmDelivery,
+		failDelivery:         failDelivery,
+		rateDelivery:         rateDelivery,
 		createCourier:        createCourier,
+		changeAvailability:   changeAvailability,
+		addStoragePlace:      addStoragePlace,
+		removeStoragePlace:   removeStoragePlace,
 		getAllCouriers:       getAllCouriers,
+		getCourierStats:      getCourierStats,
 		getIncompletedOrders: getIncompletedOrders,
+		getDeadLetters:       getDeadLetters,
+		getDeadLetter:        getDeadLetter,
+		replayDeadLetter:     replayDeadLetter,
 	}, nil
 }
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) AddStoragePlace(c echo.Context, courierId uuid.UUID) error {
+	var body servers.NewStoragePlace
+	if err := c.Bind(&body); err != nil {
+		return problems.NewBadRequest("invalid JSON body: " + err.Error())
+	}
+	maxWeight := 0
+	if body.MaxWeight != nil {
+		maxWeight = *body.MaxWeight
+	}
+
+	cmd, err := commands.NewAddStoragePlaceCommand(
+		courierId,
+		body.Name,
+		courier.StoragePlaceKind(body.Kind),
+		body.TotalVolume,
+		maxWeight,
+	)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.addStoragePlace.Handle(c.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		if errors.Is(err, errs.ErrValueIsInvalid) {
+			return problems.NewBadRequest(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
+
+func (s *Server) RemoveStoragePlace(c echo.Context, courierId uuid.UUID, storagePlaceId uuid.UUID) error {
+	cmd, err := commands.NewRemoveStoragePlaceCommand(courierId, storagePlaceId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.removeStoragePlace.Handle(c.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,217 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/generated/queues/basketconfirmedpb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const messageIDHeader = "message_id"
+
+var (
+	_ sarama.ConsumerGroupHandler = (*BasketConfirmedConsumer)(nil)
+	_ ports.MessageReplayer       = (*BasketConfirmedConsumer)(nil)
+)
+
+type BasketConfirmedConsumer struct {
+	topic       string
+	group       sarama.ConsumerGroup
+	createOrder commands.CreateOrderCommandHandler
+	deadLetters *deadLetterBox
+}
+
+func NewBasketConfirmedConsumer(
+	brokers []string,
+	groupID string,
+	topic string,
+	createOrder commands.CreateOrderCommandHandler,
+	deadLetterPolicy DeadLetterPolicy,
+) (*BasketConfirmedConsumer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+	if groupID == "" {
+		return nil, errs.NewValueIsRequiredError("groupID")
+	}
+	if topic == "" {
+		return nil, errs.NewValueIsRequiredError("topic")
+	}
+	if createOrder == nil {
+		return nil, errs.NewValueIsRequiredError("createOrder")
+	}
+	deadLetters, err := newDeadLetterBox(deadLetterPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	config := sarama.NewConfig()
+	config.Consumer.Return.Errors = true
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	// Оффсет фиксируется вручную и только после успешной обработки сообщения
+	config.Consumer.Offsets.AutoCommit.Enable = false
+
+	group, err := sarama.NewConsumerGroup(brokers, groupID, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BasketConfirmedConsumer{
+		topic:       topic,
+		group:       group,
+		createOrder: createOrder,
+		deadLetters: deadLetters,
+	}, nil
+}
+
+// Consume blocks until ctx is done or the consumer is closed.
+// A message that failed to be handled ends the session, so it is redelivered
+// from the last committed offset after the group rejoins. After too many
+// failed attempts the message is moved to dead letters and skipped.
+func (c *BasketConfirmedConsumer) Consume(ctx context.Context) error {
+	go func() {
+		for err := range c.group.Errors() {
+			log.Errorf("consume %s: %v", c.topic, err)
+		}
+	}()
+
+	for {
+		err := c.group.Consume(ctx, []string{c.topic}, c)
+		if err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return nil
+			}
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (c *BasketConfirmedConsumer) Close() error {
+	return c.group.Close()
+}
+
+func (c *BasketConfirmedConsumer) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+func (c *BasketConfirmedConsumer) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (c *BasketConfirmedConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			id := messageID(message)
+			if err := c.handle(session.Context(), message.Value); err != nil {
+				moved, dlErr := c.deadLetters.fail(session.Context(), message.Topic, id, message.Value, err)
+				if dlErr != nil {
+					err = errors.Join(err, dlErr)
+				}
+				if !moved {
+					return fmt.Errorf("handle message %s/%d/%d: %w",
+						message.Topic, message.Partition, message.Offset, err)
+				}
+			} else {
+				c.deadLetters.forget(id)
+			}
+
+			session.MarkMessage(message, "")
+			session.Commit()
+		}
+	}
+}
+
+func (c *BasketConfirmedConsumer) Source() string {
+	return c.topic
+}
+
+// Replay handles a dead letter of the consumer once more.
+func (c *BasketConfirmedConsumer) Replay(ctx context.Context, deadLetter ports.DeadLetter) error {
+	return c.handle(ctx, deadLetter.Payload)
+}
+
+func (c *BasketConfirmedConsumer) handle(ctx context.Context, payload []byte) error {
+	var event basketconfirmedpb.BasketConfirmedIntegrationEvent
+	err := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(payload, &event)
+	if err != nil {
+		return errs.NewValueIsInvalidErrorWithCause("message", err)
+	}
+
+	basketID, err := uuid.Parse(event.GetBasketId())
+	if err != nil {
+		return errs.NewValueIsInvalidErrorWithCause("basketId", err)
+	}
+
+	handling := order.HandlingAmbient
+	if event.GetHandling() != "" {
+		handling = order.Handling(event.GetHandling())
+	}
+
+	priority := order.PriorityStandard
+	if event.GetPriority() != "" {
+		priority = order.Priority(event.GetPriority())
+	}
+
+	var promisedBy *time.Time
+	if event.GetPromisedBy() != "" {
+		deadline, err := time.Parse(time.RFC3339, event.GetPromisedBy())
+		if err != nil {
+			return errs.NewValueIsInvalidErrorWithCause("promisedBy", err)
+		}
+		promisedBy = &deadline
+	}
+
+	cmd, err := commands.NewCreateOrderCommand(
+		basketID,
+		event.GetAddress().GetStreet(),
+		event.GetPickupStreet(),
+		int(event.GetVolume()),
+		int(event.GetWeight()),
+		handling,
+		priority,
+		promisedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Корзина подтверждается один раз, поэтому повторно опубликованное сообщение
+	// с новым идентификатором не должно создать второй заказ
+	ctx = ports.WithInboxMessage(ctx, ports.InboxMessage{ID: basketID.String(), Source: c.topic})
+	err = c.createOrder.Handle(ctx, cmd)
+	if errors.Is(err, ports.ErrMessageAlreadyProcessed) {
+		log.Infof("basket %s: message already processed", basketID)
+		return nil
+	}
+	return err
+}
+
+// messageID returns the id set by the producer in the message headers
+// and falls back to the message position in the topic. It identifies the delivery
+// for dead letters, while the inbox is keyed by the basket.
+func messageID(message *sarama.ConsumerMessage) string {
+	for _, header := range message.Headers {
+		if header != nil && string(header.Key) == messageIDHeader && len(header.Value) > 0 {
+			return string(header.Value)
+		}
+	}
+	return fmt.Sprintf("%s/%d/%d", message.Topic, message.Partition, message.Offset)
+}
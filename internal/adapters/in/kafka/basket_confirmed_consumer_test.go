@@ -0,0 +1,289 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	grpcout "delivery/internal/adapters/out/grpc"
+	"delivery/internal/adapters/out/inmemory"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/generated/clients/geosrv/geopb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testTopic = "basket.confirmed"
+	testGroup = "delivery-test-group"
+)
+
+type createOrderHandlerStub struct {
+	mu       sync.Mutex
+	fails    int
+	err      error
+	commands []commands.CreateOrderCommand
+	messages []ports.InboxMessage
+}
+
+func (h *createOrderHandlerStub) Handle(ctx context.Context, command commands.CreateOrderCommand) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.commands = append(h.commands, command)
+	if message, ok := ports.InboxMessageFromContext(ctx); ok {
+		h.messages = append(h.messages, message)
+	}
+	if h.fails > 0 {
+		h.fails--
+		return errors.New("temporary failure")
+	}
+	return h.err
+}
+
+func (h *createOrderHandlerStub) Commands() []commands.CreateOrderCommand {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]commands.CreateOrderCommand(nil), h.commands...)
+}
+
+func Test_BasketConfirmedConsumer(t *testing.T) {
+	basketID := uuid.New()
+	message := `{"BasketId":"` + basketID.String() + `","Address":{"Street":"Тестировочная"},"Volume":5,"PickupStreet":"Складская","Handling":"Refrigerated","Weight":1200,"Priority":"Express","PromisedBy":"2030-01-02T15:04:05Z"}`
+
+	tests := []struct {
+		name        string
+		fails       int
+		err         error
+		handled     int
+		deadLetters int
+	}{
+		{name: "good", fails: 0, handled: 1},
+		{name: "good redelivered after failure", fails: 1, handled: 2},
+		{name: "good retried after transient failures", fails: 3, handled: 4},
+		{name: "good already processed", err: ports.ErrMessageAlreadyProcessed, handled: 1},
+		{name: "bad moved to dead letters", err: errs.NewValueIsInvalidError("street"), handled: 2, deadLetters: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			broker := startBroker(t, message)
+			handler := &createOrderHandlerStub{fails: tt.fails, err: tt.err}
+			deadLetters := inmemory.NewDeadLetterRepository()
+			policy := DeadLetterPolicy{MaxAttempts: 2, Repository: deadLetters}
+
+			consumer, err := NewBasketConfirmedConsumer([]string{broker.Addr()}, testGroup, testTopic, handler, policy)
+			require.NoError(err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error)
+			go func() { done <- consumer.Consume(ctx) }()
+
+			require.Eventually(func() bool {
+				return len(committedOffsets(broker)) > 0
+			}, 30*time.Second, 100*time.Millisecond)
+
+			cancel()
+			assert.NoError(consumer.Close())
+			assert.NoError(<-done)
+
+			got := handler.Commands()
+			assert.Len(got, tt.handled)
+			for _, command := range got {
+				assert.Equal(basketID, command.OrderID())
+				assert.Equal("Тестировочная", command.Street())
+				assert.Equal(5, command.Volume())
+				assert.Equal("Складская", command.PickupStreet())
+				assert.Equal(1200, command.Weight())
+				assert.Equal(order.HandlingRefrigerated, command.Handling())
+				assert.Equal(order.PriorityExpress, command.Priority())
+				assert.Equal(time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC), *command.PromisedBy())
+			}
+			assert.Equal([]int64{1}, committedOffsets(broker)[:1])
+			for _, message := range handler.messages {
+				assert.Equal(ports.InboxMessage{ID: basketID.String(), Source: testTopic}, message)
+			}
+
+			assert.Len(deadLetters.DeadLetters(), tt.deadLetters)
+			for _, deadLetter := range deadLetters.DeadLetters() {
+				assert.Equal(testTopic, deadLetter.Source)
+				assert.Equal(testTopic+"/0/0", deadLetter.MessageID)
+				assert.Equal([]byte(message), deadLetter.Payload)
+				assert.Equal(2, deadLetter.Attempts)
+				assert.ErrorIs(deadLetter.Err, errs.ErrValueIsInvalid)
+			}
+		})
+	}
+}
+
+type unknownStreetGeoServer struct {
+	geopb.UnimplementedGeoServer
+	calls atomic.Int32
+}
+
+func (s *unknownStreetGeoServer) GetGeolocation(context.Context, *geopb.GetGeolocationRequest) (*geopb.GetGeolocationReply, error) {
+	s.calls.Add(1)
+	return nil, status.Error(codes.NotFound, "street not found")
+}
+
+// unitOfWorkStub is enough for handlers which fail before touching repositories.
+type unitOfWorkStub struct{}
+
+func (unitOfWorkStub) New(context.Context) (ports.UnitOfWork, error) { return unitOfWorkStub{}, nil }
+func (unitOfWorkStub) Begin(context.Context)                         {}
+func (unitOfWorkStub) Commit(context.Context) error                  { return nil }
+func (unitOfWorkStub) RollbackUnlessCommitted(context.Context)       {}
+func (unitOfWorkStub) OrderRepository() ports.OrderRepository        { return nil }
+func (unitOfWorkStub) CourierRepository() ports.CourierRepository    { return nil }
+func (unitOfWorkStub) InboxRepository() ports.InboxRepository        { return nil }
+
+func Test_BasketConfirmedConsumerDeadLettersUnknownStreet(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	// Геосервис не знает улицу
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	geoServer := &unknownStreetGeoServer{}
+	server := grpc.NewServer()
+	geopb.RegisterGeoServer(server, geoServer)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	geoClient, err := grpcout.NewClient(listener.Addr().String(), kernel.DefaultArea())
+	require.NoError(err)
+	t.Cleanup(func() { _ = geoClient.Close() })
+	handler, err := commands.NewCreateOrderCommandHandler(unitOfWorkStub{}, geoClient, "", false)
+	require.NoError(err)
+
+	basketID := uuid.New()
+	message := `{"BasketId":"` + basketID.String() + `","Address":{"Street":"Несуществующая"},"Volume":5}`
+	broker := startBroker(t, message)
+	deadLetters := inmemory.NewDeadLetterRepository()
+	policy := DeadLetterPolicy{MaxAttempts: 3, Repository: deadLetters}
+
+	consumer, err := NewBasketConfirmedConsumer([]string{broker.Addr()}, testGroup, testTopic, handler, policy)
+	require.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- consumer.Consume(ctx) }()
+
+	require.Eventually(func() bool {
+		return len(committedOffsets(broker)) > 0
+	}, 30*time.Second, 100*time.Millisecond)
+
+	cancel()
+	assert.NoError(consumer.Close())
+	assert.NoError(<-done)
+
+	// Сообщение отложено после MaxAttempts попыток и больше не блокирует партицию
+	assert.Equal(int32(3), geoServer.calls.Load())
+	assert.Equal([]int64{1}, committedOffsets(broker)[:1])
+	require.Len(deadLetters.DeadLetters(), 1)
+	deadLetter := deadLetters.DeadLetters()[0]
+	assert.Equal(3, deadLetter.Attempts)
+	assert.Equal([]byte(message), deadLetter.Payload)
+	assert.ErrorIs(deadLetter.Err, errs.ErrValueIsInvalid)
+}
+
+func TestNewBasketConfirmedConsumer(t *testing.T) {
+	policy := DeadLetterPolicy{Repository: inmemory.NewDeadLetterRepository()}
+
+	_, err := NewBasketConfirmedConsumer(nil, testGroup, testTopic, &createOrderHandlerStub{}, policy)
+	assert.Error(t, err)
+
+	_, err = NewBasketConfirmedConsumer([]string{"localhost:9092"}, testGroup, testTopic, nil, policy)
+	assert.Error(t, err)
+
+	_, err = NewBasketConfirmedConsumer([]string{"localhost:9092"}, testGroup, testTopic, &createOrderHandlerStub{}, DeadLetterPolicy{})
+	assert.Error(t, err)
+}
+
+func TestBasketConfirmedConsumer_Replay(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := &createOrderHandlerStub{}
+	consumer := &BasketConfirmedConsumer{topic: testTopic, createOrder: handler}
+	assert.Equal(testTopic, consumer.Source())
+
+	basketID := uuid.New()
+	err := consumer.Replay(context.Background(), ports.DeadLetter{
+		MessageID: "id",
+		Source:    testTopic,
+		Payload:   []byte(`{"BasketId":"` + basketID.String() + `","Address":{"Street":"Тестировочная"},"Volume":5}`),
+	})
+	assert.NoError(err)
+	assert.Len(handler.Commands(), 1)
+	assert.Equal([]ports.InboxMessage{{ID: basketID.String(), Source: testTopic}}, handler.messages)
+
+	err = consumer.Replay(context.Background(), ports.DeadLetter{Payload: []byte("not json")})
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+}
+
+func startBroker(t *testing.T, message string) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 0)
+	t.Cleanup(broker.Close)
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"ApiVersionsRequest": sarama.NewMockApiVersionsResponse(t),
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(testTopic, 0, broker.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetOffset(testTopic, 0, sarama.OffsetOldest, 0).
+			SetOffset(testTopic, 0, sarama.OffsetNewest, 1),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, testGroup, broker),
+		"HeartbeatRequest": sarama.NewMockHeartbeatResponse(t),
+		"JoinGroupRequest": sarama.NewMockJoinGroupResponse(t).
+			SetGroupProtocol(sarama.RangeBalanceStrategyName),
+		"SyncGroupRequest": sarama.NewMockSyncGroupResponse(t).
+			SetMemberAssignment(&sarama.ConsumerGroupMemberAssignment{
+				Topics: map[string][]int32{testTopic: {0}},
+			}),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset(testGroup, testTopic, 0, -1, "", sarama.ErrNoError).
+			SetError(sarama.ErrNoError),
+		"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			SetMessage(testTopic, 0, 0, sarama.StringEncoder(message)).
+			SetHighWaterMark(testTopic, 0, 1),
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+		"LeaveGroupRequest":   sarama.NewMockLeaveGroupResponse(t),
+	})
+
+	return broker
+}
+
+func committedOffsets(broker *sarama.MockBroker) []int64 {
+	var offsets []int64
+	for _, rr := range broker.History() {
+		req, ok := rr.Request.(*sarama.OffsetCommitRequest)
+		if !ok {
+			continue
+		}
+
+		offset, _, err := req.Offset(testTopic, 0)
+		if err == nil {
+			offsets = append(offsets, offset)
+		}
+	}
+	return offsets
+}
@@ -0,0 +1,98 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+const defaultMaxAttempts = 5
+
+// DeadLetterPolicy describes when and where poison messages are moved.
+type DeadLetterPolicy struct {
+	// MaxAttempts is the number of failed attempts after which a message becomes a dead letter.
+	MaxAttempts int
+	Repository  ports.DeadLetterRepository
+	// Publisher is optional and forwards dead letters to a DLQ topic.
+	Publisher ports.DeadLetterPublisher
+}
+
+// deadLetterBox counts failed attempts of messages and moves exhausted ones to dead letters.
+type deadLetterBox struct {
+	policy DeadLetterPolicy
+
+	mu       sync.Mutex
+	attempts map[string]int
+}
+
+func newDeadLetterBox(policy DeadLetterPolicy) (*deadLetterBox, error) {
+	if policy.Repository == nil {
+		return nil, errs.NewValueIsRequiredError("policy.Repository")
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaultMaxAttempts
+	}
+	return &deadLetterBox{policy: policy, attempts: make(map[string]int)}, nil
+}
+
+// fail registers a failed attempt and reports whether the message was moved to dead letters.
+// Only poison messages are counted, others are retried until the cause goes away.
+func (b *deadLetterBox) fail(ctx context.Context, source, messageID string, payload []byte, cause error) (bool, error) {
+	if !isPoison(cause) {
+		return false, nil
+	}
+
+	b.mu.Lock()
+	b.attempts[messageID]++
+	attempts := b.attempts[messageID]
+	b.mu.Unlock()
+
+	if attempts < b.policy.MaxAttempts {
+		return false, nil
+	}
+
+	deadLetter := ports.DeadLetter{
+		ID:           uuid.New(),
+		MessageID:    messageID,
+		Source:       source,
+		Payload:      payload,
+		Err:          cause,
+		Attempts:     attempts,
+		CreatedAtUtc: time.Now().UTC(),
+	}
+	if err := b.policy.Repository.Add(ctx, deadLetter); err != nil {
+		return false, err
+	}
+	log.Warnf("message %s from %s moved to dead letters after %d attempts: %v", messageID, source, attempts, cause)
+
+	if b.policy.Publisher != nil {
+		// Письмо уже сохранено, ошибка публикации не должна блокировать очередь
+		if err := b.policy.Publisher.Publish(ctx, deadLetter); err != nil {
+			log.Errorf("publish dead letter %s: %v", deadLetter.ID, err)
+		}
+	}
+
+	b.forget(messageID)
+	return true, nil
+}
+
+func (b *deadLetterBox) forget(messageID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.attempts, messageID)
+}
+
+// isPoison reports whether the message itself is bad, so handling it again cannot help.
+// Failures of the DB or brokers are transient and must not move good messages to dead letters.
+func isPoison(err error) bool {
+	return errors.Is(err, errs.ErrValueIsInvalid) ||
+		errors.Is(err, errs.ErrValueIsRequired) ||
+		errors.Is(err, errs.ErrValueIsOutOfRange)
+}
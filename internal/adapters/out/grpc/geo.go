@@ -10,7 +10,9 @@ import (
 	"delivery/internal/pkg/errs"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 var _ ports.GeoClient = &Client{}
@@ -19,12 +21,16 @@ type Client struct {
 	conn    *grpc.ClientConn
 	client  geopb.GeoClient
 	timeout time.Duration
+	area    kernel.Area
 }
 
-func NewClient(host string) (*Client, error) {
+func NewClient(host string, area kernel.Area) (*Client, error) {
 	if host == "" {
 		return nil, errs.NewValueIsRequiredError("host")
 	}
+	if !area.IsValid() {
+		return nil, errs.NewValueIsRequiredError("area")
+	}
 
 	conn, err := grpc.NewClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -35,6 +41,7 @@ func NewClient(host string) (*Client, error) {
 		conn:    conn,
 		client:  geopb.NewGeoClient(conn),
 		timeout: 5 * time.Second,
+		area:    area,
 	}, nil
 }
 
@@ -42,10 +49,18 @@ func (c *Client) GetGeolocation(ctx context.Context, street string) (kernel.Loca
 	req := &geopb.GetGeolocationRequest{Street: street}
 	res, err := c.client.GetGeolocation(ctx, req)
 	if err != nil {
+		// Неизвестный адрес не найдется и при повторе, остальные ошибки временные
+		switch status.Code(err) {
+		case codes.NotFound, codes.InvalidArgument:
+			return kernel.Location{}, errs.NewValueIsInvalidErrorWithCause("street", err)
+		}
 		return kernel.Location{}, err
 	}
 
-	return kernel.NewLocation(int(res.Location.X), int(res.Location.Y))
+	// Геосервис может знать адреса за пределами зоны доставки - такие заказы не принимаем.
+	// Контракт геосервиса содержит только клетку сетки, поэтому в географической зоне
+	// заказ получает координаты центра клетки, а не точку дома.
+	return c.area.NewLocation(int(res.Location.X), int(res.Location.Y))
 }
 
 func (c *Client) Close() error {
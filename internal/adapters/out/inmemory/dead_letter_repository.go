@@ -0,0 +1,63 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+var _ ports.DeadLetterRepository = (*DeadLetterRepository)(nil)
+
+// DeadLetterRepository keeps dead letters in memory, it is intended for tests.
+type DeadLetterRepository struct {
+	mu          sync.Mutex
+	deadLetters []ports.DeadLetter
+}
+
+func NewDeadLetterRepository() *DeadLetterRepository {
+	return &DeadLetterRepository{}
+}
+
+func (r *DeadLetterRepository) Add(_ context.Context, deadLetter ports.DeadLetter) error {
+	if deadLetter.ID == uuid.Nil {
+		return errs.NewValueIsRequiredError("deadLetter.ID")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deadLetters = append(r.deadLetters, deadLetter)
+	return nil
+}
+
+func (r *DeadLetterRepository) Update(_ context.Context, deadLetter ports.DeadLetter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.deadLetters {
+		if r.deadLetters[i].ID == deadLetter.ID {
+			r.deadLetters[i] = deadLetter
+			return nil
+		}
+	}
+	return errs.NewObjectNotFoundError("deadLetter", deadLetter.ID)
+}
+
+func (r *DeadLetterRepository) Get(_ context.Context, ID uuid.UUID) (ports.DeadLetter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, deadLetter := range r.deadLetters {
+		if deadLetter.ID == ID {
+			return deadLetter, nil
+		}
+	}
+	return ports.DeadLetter{}, errs.NewObjectNotFoundError("deadLetter", ID)
+}
+
+func (r *DeadLetterRepository) DeadLetters() []ports.DeadLetter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]ports.DeadLetter(nil), r.deadLetters...)
+}
@@ -0,0 +1,39 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+)
+
+var _ ports.EventPublisher = (*EventPublisher)(nil)
+
+// EventPublisher keeps published events in memory, it is intended for tests.
+type EventPublisher struct {
+	mu     sync.Mutex
+	events []ddd.DomainEvent
+}
+
+func NewEventPublisher() *EventPublisher {
+	return &EventPublisher{}
+}
+
+func (p *EventPublisher) Publish(_ context.Context, event ddd.DomainEvent) error {
+	if event == nil {
+		return errs.NewValueIsRequiredError("event")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *EventPublisher) Events() []ddd.DomainEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]ddd.DomainEvent(nil), p.events...)
+}
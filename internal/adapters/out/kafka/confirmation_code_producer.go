@@ -0,0 +1,70 @@
+package kafka
+
+import (
+	"context"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/generated/queues/orderconfirmationcodepb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ ports.ConfirmationCodeSender = (*ConfirmationCodeProducer)(nil)
+
+// ConfirmationCodeProducer sends confirmation codes to a dedicated topic read by
+// the customer notification service. Codes never go to the order status topic.
+type ConfirmationCodeProducer struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func NewConfirmationCodeProducer(brokers []string, topic string) (*ConfirmationCodeProducer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+	if topic == "" {
+		return nil, errs.NewValueIsRequiredError("topic")
+	}
+
+	config := sarama.NewConfig()
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConfirmationCodeProducer{topic: topic, producer: producer}, nil
+}
+
+func (p *ConfirmationCodeProducer) Send(_ context.Context, orderID uuid.UUID, code string) error {
+	if orderID == uuid.Nil {
+		return errs.NewValueIsRequiredError("orderID")
+	}
+	if code == "" {
+		return errs.NewValueIsRequiredError("code")
+	}
+
+	payload, err := protojson.Marshal(&orderconfirmationcodepb.OrderConfirmationCodeIssuedIntegrationEvent{
+		OrderId: orderID.String(),
+		Code:    code,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, _, err = p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(orderID.String()),
+		Value: sarama.ByteEncoder(payload),
+	})
+	return err
+}
+
+func (p *ConfirmationCodeProducer) Close() error {
+	return p.producer.Close()
+}
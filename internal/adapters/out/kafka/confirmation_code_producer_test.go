@@ -0,0 +1,47 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/generated/queues/orderconfirmationcodepb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestConfirmationCodeProducer_Send(t *testing.T) {
+	assert := assert.New(t)
+
+	orderID := uuid.New()
+
+	mock := mocks.NewSyncProducer(t, nil)
+	mock.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		assert.Equal("order.confirmation.code", msg.Topic)
+
+		key, err := msg.Key.Encode()
+		assert.NoError(err)
+		assert.Equal(orderID.String(), string(key))
+
+		value, err := msg.Value.Encode()
+		assert.NoError(err)
+
+		var got orderconfirmationcodepb.OrderConfirmationCodeIssuedIntegrationEvent
+		assert.NoError(protojson.Unmarshal(value, &got))
+		assert.Equal(orderID.String(), got.GetOrderId())
+		assert.Equal("0042", got.GetCode())
+		return nil
+	})
+
+	producer := &ConfirmationCodeProducer{topic: "order.confirmation.code", producer: mock}
+	assert.NoError(producer.Send(context.Background(), orderID, "0042"))
+
+	// Без заказа или кода сообщение не отправляется
+	assert.ErrorIs(producer.Send(context.Background(), uuid.Nil, "0042"), errs.ErrValueIsRequired)
+	assert.ErrorIs(producer.Send(context.Background(), orderID, ""), errs.ErrValueIsRequired)
+	assert.NoError(producer.Close())
+}
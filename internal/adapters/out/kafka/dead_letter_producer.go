@@ -0,0 +1,66 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/IBM/sarama"
+)
+
+var _ ports.DeadLetterPublisher = (*DeadLetterProducer)(nil)
+
+// DeadLetterProducer forwards the original payload of dead letters to a DLQ topic,
+// the failure details are passed in headers.
+type DeadLetterProducer struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func NewDeadLetterProducer(brokers []string, topic string) (*DeadLetterProducer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+	if topic == "" {
+		return nil, errs.NewValueIsRequiredError("topic")
+	}
+
+	config := sarama.NewConfig()
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeadLetterProducer{topic: topic, producer: producer}, nil
+}
+
+func (p *DeadLetterProducer) Publish(_ context.Context, deadLetter ports.DeadLetter) error {
+	var errorMessage string
+	if deadLetter.Err != nil {
+		errorMessage = deadLetter.Err.Error()
+	}
+
+	_, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(deadLetter.MessageID),
+		Value: sarama.ByteEncoder(deadLetter.Payload),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("dead_letter_id"), Value: []byte(deadLetter.ID.String())},
+			{Key: []byte("message_id"), Value: []byte(deadLetter.MessageID)},
+			{Key: []byte("source"), Value: []byte(deadLetter.Source)},
+			{Key: []byte("error_kind"), Value: []byte(errs.Kind(deadLetter.Err))},
+			{Key: []byte("error"), Value: []byte(errorMessage)},
+			{Key: []byte("attempts"), Value: []byte(strconv.Itoa(deadLetter.Attempts))},
+		},
+	})
+	return err
+}
+
+func (p *DeadLetterProducer) Close() error {
+	return p.producer.Close()
+}
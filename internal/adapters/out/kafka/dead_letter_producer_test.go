@@ -0,0 +1,49 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadLetterProducer_Publish(t *testing.T) {
+	assert := assert.New(t)
+
+	deadLetter := ports.DeadLetter{
+		ID:        uuid.New(),
+		MessageID: "basket.confirmed/0/1",
+		Source:    "basket.confirmed",
+		Payload:   []byte(`{"Volume":0}`),
+		Err:       errs.NewValueIsRequiredError("volume"),
+		Attempts:  5,
+	}
+
+	mock := mocks.NewSyncProducer(t, nil)
+	mock.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		assert.Equal("basket.confirmed.dlq", msg.Topic)
+
+		value, err := msg.Value.Encode()
+		assert.NoError(err)
+		assert.Equal(deadLetter.Payload, value)
+
+		headers := make(map[string]string, len(msg.Headers))
+		for _, header := range msg.Headers {
+			headers[string(header.Key)] = string(header.Value)
+		}
+		assert.Equal(deadLetter.ID.String(), headers["dead_letter_id"])
+		assert.Equal(errs.KindValueIsRequired, headers["error_kind"])
+		assert.Equal("5", headers["attempts"])
+		return nil
+	})
+
+	producer := &DeadLetterProducer{topic: "basket.confirmed.dlq", producer: mock}
+	assert.NoError(producer.Publish(context.Background(), deadLetter))
+	assert.NoError(producer.Close())
+}
@@ -0,0 +1,103 @@
+package kafka
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/generated/queues/orderstatuschangedpb"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ ports.EventPublisher = (*OrderStatusChangedProducer)(nil)
+
+// orderStatusChangedEvent is a domain event which carries the new status of an order.
+type orderStatusChangedEvent interface {
+	ddd.DomainEvent
+	GetOrderID() uuid.UUID
+	GetOrderStatus() order.Status
+}
+
+type OrderStatusChangedProducer struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func NewOrderStatusChangedProducer(brokers []string, topic string) (*OrderStatusChangedProducer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+	if topic == "" {
+		return nil, errs.NewValueIsRequiredError("topic")
+	}
+
+	config := sarama.NewConfig()
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderStatusChangedProducer{topic: topic, producer: producer}, nil
+}
+
+// Publish sends order status changes to Kafka, other events are skipped.
+func (p *OrderStatusChangedProducer) Publish(_ context.Context, event ddd.DomainEvent) error {
+	if event == nil {
+		return errs.NewValueIsRequiredError("event")
+	}
+
+	orderEvent, ok := event.(orderStatusChangedEvent)
+	if !ok {
+		return nil
+	}
+
+	payload, err := protojson.Marshal(&orderstatuschangedpb.OrderStatusChangedIntegrationEvent{
+		OrderId:     orderEvent.GetOrderID().String(),
+		OrderStatus: statusToProto(orderEvent.GetOrderStatus()),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, _, err = p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(orderEvent.GetOrderID().String()),
+		Value: sarama.ByteEncoder(payload),
+	})
+	return err
+}
+
+func (p *OrderStatusChangedProducer) Close() error {
+	return p.producer.Close()
+}
+
+func statusToProto(status order.Status) orderstatuschangedpb.OrderStatus {
+	switch status {
+	case order.StatusCreated:
+		return orderstatuschangedpb.OrderStatus_Created
+	case order.StatusAssigned:
+		return orderstatuschangedpb.OrderStatus_Assigned
+	case order.StatusPickedUp:
+		return orderstatuschangedpb.OrderStatus_PickedUp
+	case order.StatusAwaitingConfirmation:
+		return orderstatuschangedpb.OrderStatus_AwaitingConfirmation
+	case order.StatusFailed:
+		return orderstatuschangedpb.OrderStatus_Failed
+	case order.StatusReturnedToSender:
+		return orderstatuschangedpb.OrderStatus_ReturnedToSender
+	case order.StatusCompleted:
+		return orderstatuschangedpb.OrderStatus_Completed
+	case order.StatusCancelled:
+		return orderstatuschangedpb.OrderStatus_Cancelled
+	default:
+		return orderstatuschangedpb.OrderStatus_None
+	}
+}
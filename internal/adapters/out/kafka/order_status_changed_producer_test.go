@@ -0,0 +1,67 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/generated/queues/orderstatuschangedpb"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const testTopic = "order.status.changed"
+
+type testOrderEvent struct {
+	id      uuid.UUID
+	orderID uuid.UUID
+	status  order.Status
+}
+
+func (e testOrderEvent) GetID() uuid.UUID { return e.id }
+
+func (e testOrderEvent) GetName() string { return "test.order" }
+
+func (e testOrderEvent) GetOrderID() uuid.UUID { return e.orderID }
+
+func (e testOrderEvent) GetOrderStatus() order.Status { return e.status }
+
+type testOtherEvent struct{}
+
+func (testOtherEvent) GetID() uuid.UUID { return uuid.New() }
+
+func (testOtherEvent) GetName() string { return "test.other" }
+
+func TestOrderStatusChangedProducer_Publish(t *testing.T) {
+	assert := assert.New(t)
+
+	event := testOrderEvent{id: uuid.New(), orderID: uuid.New(), status: order.StatusAssigned}
+
+	mock := mocks.NewSyncProducer(t, nil)
+	mock.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		assert.Equal(testTopic, msg.Topic)
+
+		key, err := msg.Key.Encode()
+		assert.NoError(err)
+		assert.Equal(event.orderID.String(), string(key))
+
+		value, err := msg.Value.Encode()
+		assert.NoError(err)
+
+		var got orderstatuschangedpb.OrderStatusChangedIntegrationEvent
+		assert.NoError(protojson.Unmarshal(value, &got))
+		assert.Equal(event.orderID.String(), got.GetOrderId())
+		assert.Equal(orderstatuschangedpb.OrderStatus_Assigned, got.GetOrderStatus())
+		return nil
+	})
+
+	producer := &OrderStatusChangedProducer{topic: testTopic, producer: mock}
+	assert.NoError(producer.Publish(context.Background(), event))
+	// остальные события не публикуются
+	assert.NoError(producer.Publish(context.Background(), testOtherEvent{}))
+	assert.NoError(producer.Close())
+}
@@ -7,9 +7,12 @@ import (
 type CourierDTO struct {
 	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
 	Name          string
+	TransportType string `gorm:"type:varchar(20)"`
 	Speed         int
 	Location      LocationDTO        `gorm:"embedded;embeddedPrefix:location_"`
+	Availability  string             `gorm:"not null;default:Online;index"`
 	StoragePlaces []*StoragePlaceDTO `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE;"`
+	Route         []RouteStopDTO     `gorm:"type:jsonb;serializer:json"`
 }
 
 func (CourierDTO) TableName() string {
@@ -17,17 +20,43 @@ func (CourierDTO) TableName() string {
 }
 
 type LocationDTO struct {
-	X, Y int
+	X   int      `json:"x"`
+	Y   int      `json:"y"`
+	Lat *float64 `json:"lat,omitempty"`
+	Lon *float64 `json:"lon,omitempty"`
+}
+
+type RouteStopDTO struct {
+	OrderID  uuid.UUID   `json:"orderId"`
+	Kind     string      `json:"kind,omitempty"`
+	Location LocationDTO `json:"location"`
 }
 
 type StoragePlaceDTO struct {
 	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
 	Name        string
+	Kind        string `gorm:"type:varchar(20);not null;default:Ambient"`
 	TotalVolume int
-	OrderID     uuid.UUID `gorm:"type:uuid"`
-	CourierID   uuid.UUID `gorm:"type:uuid"`
+	// MaxWeight - допустимая нагрузка в граммах, 0 - без ограничения
+	MaxWeight int `gorm:"not null;default:0"`
+	// OrderID is the single order of rows written before storage places could share
+	// their volume. It is moved to Orders by MigrateStoredOrders and never written.
+	OrderID   *uuid.UUID        `gorm:"type:uuid"`
+	CourierID uuid.UUID         `gorm:"type:uuid"`
+	Orders    []*StoredOrderDTO `gorm:"foreignKey:StoragePlaceID;constraint:OnDelete:CASCADE;"`
 }
 
 func (StoragePlaceDTO) TableName() string {
 	return "storage_places"
 }
+
+type StoredOrderDTO struct {
+	OrderID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	StoragePlaceID uuid.UUID `gorm:"type:uuid;index"`
+	Volume         int
+	Weight         int `gorm:"not null;default:0"`
+}
+
+func (StoredOrderDTO) TableName() string {
+	return "storage_place_orders"
+}
@@ -3,44 +3,104 @@ package courierrepo
 import (
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
-
-	"github.com/google/uuid"
 )
 
 func DomainToDTO(courier *courier.Courier) CourierDTO {
 	places := make([]*StoragePlaceDTO, 0, len(courier.StoragePlaces()))
 	for _, v := range courier.StoragePlaces() {
-		oid := uuid.Nil
-		if v.OrderID() != nil {
-			oid = *v.OrderID()
+		orders := make([]*StoredOrderDTO, 0, len(v.Orders()))
+		for _, stored := range v.Orders() {
+			orders = append(orders, &StoredOrderDTO{
+				OrderID:        stored.OrderID(),
+				StoragePlaceID: v.ID(),
+				Volume:         stored.Volume(),
+				Weight:         stored.Weight(),
+			})
 		}
 
 		places = append(places, &StoragePlaceDTO{
 			ID:          v.ID(),
 			Name:        v.Name(),
+			Kind:        v.Kind().String(),
 			TotalVolume: v.TotalVolume(),
-			OrderID:     oid,
+			MaxWeight:   v.MaxWeight(),
+			Orders:      orders,
+		})
+	}
+
+	route := make([]RouteStopDTO, 0, len(courier.Route()))
+	for _, stop := range courier.Route() {
+		route = append(route, RouteStopDTO{
+			OrderID:  stop.OrderID(),
+			Kind:     stop.Kind().String(),
+			Location: locationToDTO(stop.Location()),
 		})
 	}
 
 	return CourierDTO{
-		ID:    courier.ID(),
-		Name:  courier.Name(),
-		Speed: courier.Speed(),
-		Location: LocationDTO{
-			X: courier.Location().X(),
-			Y: courier.Location().Y(),
-		},
+		ID:            courier.ID(),
+		Name:          courier.Name(),
+		TransportType: courier.Transport().String(),
+		Speed:         courier.Speed(),
+		Location:      locationToDTO(courier.Location()),
+		Availability:  courier.Availability().String(),
 		StoragePlaces: places,
+		Route:         route,
 	}
 }
 
 func DtoToDomain(dto CourierDTO) *courier.Courier {
 	places := make([]*courier.StoragePlace, 0, len(dto.StoragePlaces))
 	for _, place := range dto.StoragePlaces {
-		places = append(places, courier.RestoreStoragePlace(place.ID, place.Name, place.TotalVolume, place.OrderID))
+		orders := make([]courier.StoredOrder, 0, len(place.Orders))
+		for _, stored := range place.Orders {
+			orders = append(orders, courier.RestoreStoredOrder(stored.OrderID, stored.Volume, stored.Weight))
+		}
+		kind := courier.StoragePlaceKind(place.Kind)
+		if kind.IsEmpty() {
+			kind = courier.StoragePlaceKindAmbient
+		}
+		places = append(places, courier.RestoreStoragePlace(place.ID, place.Name, kind, place.TotalVolume, place.MaxWeight, orders))
+	}
+
+	loc := locationFromDTO(dto.Location)
+	availability := courier.Availability(dto.Availability)
+	if availability.IsEmpty() {
+		availability = courier.AvailabilityOnline
+	}
+	route := make([]courier.RouteStop, 0, len(dto.Route))
+	for _, stop := range dto.Route {
+		stopLoc := locationFromDTO(stop.Location)
+		kind := courier.StopKind(stop.Kind)
+		if kind == courier.StopKindEmpty {
+			kind = courier.StopKindDropoff
+		}
+		routeStop, _ := courier.NewRouteStopOfKind(stop.OrderID, kind, stopLoc)
+		route = append(route, routeStop)
 	}
 
-	loc, _ := kernel.NewLocation(dto.Location.X, dto.Location.Y)
-	return courier.RestoreCourier(dto.ID, dto.Name, dto.Speed, loc, places)
+	transport := courier.TransportType(dto.TransportType)
+	if transport.IsEmpty() {
+		transport = courier.TransportTypeForSpeed(dto.Speed)
+	}
+
+	return courier.RestoreCourier(dto.ID, dto.Name, transport, dto.Speed, loc, availability, places, route)
+}
+
+func locationToDTO(location kernel.Location) LocationDTO {
+	dto := LocationDTO{X: location.X(), Y: location.Y()}
+	if location.IsGeographic() {
+		lat, lon := location.Point().Lat(), location.Point().Lon()
+		dto.Lat, dto.Lon = &lat, &lon
+	}
+	return dto
+}
+
+func locationFromDTO(dto LocationDTO) kernel.Location {
+	if dto.Lat != nil && dto.Lon != nil {
+		if point, err := kernel.NewGeoPoint(*dto.Lat, *dto.Lon); err == nil {
+			return kernel.RestoreGeoLocation(dto.X, dto.Y, point)
+		}
+	}
+	return kernel.RestoreLocation(dto.X, dto.Y)
 }
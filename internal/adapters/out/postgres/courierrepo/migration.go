@@ -0,0 +1,41 @@
+package courierrepo
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+
+	"gorm.io/gorm"
+)
+
+// MigrateStoredOrders moves orders from the legacy storage_places.order_id column
+// to storage_place_orders. The volume is taken from the order, and when the order
+// is unknown the whole storage place is considered taken. It is safe to run twice.
+func MigrateStoredOrders(ctx context.Context, db *gorm.DB) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`
+        INSERT INTO storage_place_orders (order_id, storage_place_id, volume)
+        SELECT sp.order_id, sp.id, COALESCE(o.volume, sp.total_volume)
+        FROM storage_places sp
+        LEFT JOIN orders o ON o.id = sp.order_id
+        WHERE sp.order_id IS NOT NULL AND sp.order_id <> '00000000-0000-0000-0000-000000000000'
+        ON CONFLICT (order_id) DO NOTHING`).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(`UPDATE storage_places SET order_id = NULL WHERE order_id IS NOT NULL`).Error
+	})
+}
+
+// MigrateTransportTypes sets the transport of couriers created before transport types
+// by their speed, see courier.TransportTypeForSpeed.
+func MigrateTransportTypes(ctx context.Context, db *gorm.DB) error {
+	return db.WithContext(ctx).Exec(`
+        UPDATE couriers
+        SET transport_type = CASE WHEN speed <= 1 THEN ? WHEN speed = 2 THEN ? ELSE ? END
+        WHERE transport_type IS NULL OR transport_type = ''`,
+		courier.TransportTypePedestrian, courier.TransportTypeBicycle, courier.TransportTypeCar).
+		Error
+}
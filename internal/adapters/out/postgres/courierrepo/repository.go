@@ -60,6 +60,7 @@ func (r *Repository) Get(ctx context.Context, ID uuid.UUID) (*courier.Courier, e
 	tx := r.getTxOrDb()
 	res := tx.WithContext(ctx).
 		Preload(clause.Associations).
+		Preload("StoragePlaces.Orders").
 		Find(&dto, ID)
 	if res.RowsAffected == 0 {
 		return nil, errs.NewObjectNotFoundError("courier.id", ID)
@@ -74,10 +75,16 @@ func (r *Repository) GetAllFree(ctx context.Context) ([]*courier.Courier, error)
 	tx := r.getTxOrDb()
 	res := tx.WithContext(ctx).
 		Preload(clause.Associations).
+		Preload("StoragePlaces.Orders").
+		Where("availability = ?", courier.AvailabilityOnline.String()).
+		// Курьер свободен, пока хотя бы в одном месте хранения остался свободный объем
 		Where(`
-        NOT EXISTS (
+        EXISTS (
             SELECT 1 FROM storage_places sp
-            WHERE sp.courier_id = couriers.id AND sp.order_id IS NOT NULL
+            WHERE sp.courier_id = couriers.id AND sp.total_volume > COALESCE((
+                SELECT SUM(spo.volume) FROM storage_place_orders spo
+                WHERE spo.storage_place_id = sp.id
+            ), 0)
         )`).
 		Find(&dtos)
 	if res.Error != nil {
@@ -106,6 +113,14 @@ func (r *Repository) Update(ctx context.Context, aggregate *courier.Courier) err
 	}
 	tx := r.tracker.Tx()
 
+	// Save не удаляет дочерние записи, выданные заказы и снятые места хранения убираем сами
+	if err := deleteRemovedStoragePlaces(ctx, tx, dto); err != nil {
+		return err
+	}
+	if err := deleteRemovedStoredOrders(ctx, tx, dto); err != nil {
+		return err
+	}
+
 	err := tx.WithContext(ctx).
 		Session(&gorm.Session{FullSaveAssociations: true}).
 		Save(&dto).
@@ -124,6 +139,39 @@ func (r *Repository) Update(ctx context.Context, aggregate *courier.Courier) err
 	return nil
 }
 
+func deleteRemovedStoragePlaces(ctx context.Context, tx *gorm.DB, dto CourierDTO) error {
+	placeIDs := make([]uuid.UUID, 0, len(dto.StoragePlaces))
+	for _, place := range dto.StoragePlaces {
+		placeIDs = append(placeIDs, place.ID)
+	}
+
+	query := tx.WithContext(ctx).Where("courier_id = ?", dto.ID)
+	if len(placeIDs) > 0 {
+		query = query.Where("id NOT IN ?", placeIDs)
+	}
+	return query.Delete(&StoragePlaceDTO{}).Error
+}
+
+func deleteRemovedStoredOrders(ctx context.Context, tx *gorm.DB, dto CourierDTO) error {
+	placeIDs := make([]uuid.UUID, 0, len(dto.StoragePlaces))
+	orderIDs := make([]uuid.UUID, 0)
+	for _, place := range dto.StoragePlaces {
+		placeIDs = append(placeIDs, place.ID)
+		for _, stored := range place.Orders {
+			orderIDs = append(orderIDs, stored.OrderID)
+		}
+	}
+	if len(placeIDs) == 0 {
+		return nil
+	}
+
+	query := tx.WithContext(ctx).Where("storage_place_id IN ?", placeIDs)
+	if len(orderIDs) > 0 {
+		query = query.Where("order_id NOT IN ?", orderIDs)
+	}
+	return query.Delete(&StoredOrderDTO{}).Error
+}
+
 func (r *Repository) getTxOrDb() *gorm.DB {
 	if tx := r.tracker.Tx(); tx != nil {
 		return tx
@@ -0,0 +1,23 @@
+package deadletter
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeadLetterDTO struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	MessageID     string     `gorm:"type:varchar(255);not null"`
+	Source        string     `gorm:"type:varchar(255);not null;index"`
+	Payload       []byte     `gorm:"type:bytea"`
+	ErrorKind     string     `gorm:"type:varchar(64);not null"`
+	ErrorMessage  string     `gorm:"type:text"`
+	Attempts      int        `gorm:"not null"`
+	CreatedAtUtc  time.Time  `gorm:"not null"`
+	ReplayedAtUtc *time.Time `gorm:"index"`
+}
+
+func (DeadLetterDTO) TableName() string {
+	return "dead_letters"
+}
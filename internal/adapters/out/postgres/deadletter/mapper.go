@@ -0,0 +1,38 @@
+package deadletter
+
+import (
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+func DomainToDTO(deadLetter ports.DeadLetter) DeadLetterDTO {
+	var message string
+	if deadLetter.Err != nil {
+		message = deadLetter.Err.Error()
+	}
+
+	return DeadLetterDTO{
+		ID:            deadLetter.ID,
+		MessageID:     deadLetter.MessageID,
+		Source:        deadLetter.Source,
+		Payload:       deadLetter.Payload,
+		ErrorKind:     errs.Kind(deadLetter.Err),
+		ErrorMessage:  message,
+		Attempts:      deadLetter.Attempts,
+		CreatedAtUtc:  deadLetter.CreatedAtUtc,
+		ReplayedAtUtc: deadLetter.ReplayedAtUtc,
+	}
+}
+
+func DtoToDomain(dto DeadLetterDTO) ports.DeadLetter {
+	return ports.DeadLetter{
+		ID:            dto.ID,
+		MessageID:     dto.MessageID,
+		Source:        dto.Source,
+		Payload:       dto.Payload,
+		Err:           errs.Restore(dto.ErrorKind, dto.ErrorMessage),
+		Attempts:      dto.Attempts,
+		CreatedAtUtc:  dto.CreatedAtUtc,
+		ReplayedAtUtc: dto.ReplayedAtUtc,
+	}
+}
@@ -0,0 +1,59 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var _ ports.DeadLetterRepository = &Repository{}
+
+// Repository stores dead letters outside of the unit of work,
+// because the transaction of the failed command is already rolled back.
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*Repository, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) Add(ctx context.Context, deadLetter ports.DeadLetter) error {
+	if deadLetter.ID == uuid.Nil {
+		return errs.NewValueIsRequiredError("deadLetter.ID")
+	}
+
+	dto := DomainToDTO(deadLetter)
+	return r.db.WithContext(ctx).Create(&dto).Error
+}
+
+func (r *Repository) Update(ctx context.Context, deadLetter ports.DeadLetter) error {
+	if deadLetter.ID == uuid.Nil {
+		return errs.NewValueIsRequiredError("deadLetter.ID")
+	}
+
+	dto := DomainToDTO(deadLetter)
+	return r.db.WithContext(ctx).Save(&dto).Error
+}
+
+func (r *Repository) Get(ctx context.Context, ID uuid.UUID) (ports.DeadLetter, error) {
+	var dto DeadLetterDTO
+
+	err := r.db.WithContext(ctx).First(&dto, "id = ?", ID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ports.DeadLetter{}, errs.NewObjectNotFoundError("deadLetter", ID)
+		}
+		return ports.DeadLetter{}, err
+	}
+
+	return DtoToDomain(dto), nil
+}
@@ -0,0 +1,16 @@
+package inbox
+
+import (
+	"time"
+)
+
+// MessageDTO is keyed by the source and the message ID, so equal IDs from different sources do not collide.
+type MessageDTO struct {
+	Source         string    `gorm:"type:varchar(255);primaryKey"`
+	ID             string    `gorm:"type:varchar(255);primaryKey"`
+	ProcessedAtUtc time.Time `gorm:"not null"`
+}
+
+func (MessageDTO) TableName() string {
+	return "inbox"
+}
@@ -0,0 +1,62 @@
+package inbox
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/shared"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm/clause"
+)
+
+var _ ports.InboxRepository = &Repository{}
+
+type Repository struct {
+	tracker shared.Tracker
+}
+
+func NewRepository(tracker shared.Tracker) (ports.InboxRepository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+func (r *Repository) Register(ctx context.Context, message ports.InboxMessage) error {
+	if message.ID == "" {
+		return errs.NewValueIsRequiredError("message.ID")
+	}
+	if message.Source == "" {
+		return errs.NewValueIsRequiredError("message.Source")
+	}
+
+	dto := MessageDTO{
+		ID:             message.ID,
+		Source:         message.Source,
+		ProcessedAtUtc: time.Now().UTC(),
+	}
+
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		r.tracker.Begin(ctx)
+	}
+	tx := r.tracker.Tx()
+
+	result := tx.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&dto)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ports.ErrMessageAlreadyProcessed
+	}
+
+	if !isInTransaction {
+		err := r.tracker.Commit(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
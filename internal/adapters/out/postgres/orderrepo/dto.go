@@ -1,22 +1,48 @@
 package orderrepo
 
 import (
+	"time"
+
 	"delivery/internal/core/domain/model/order"
 
 	"github.com/google/uuid"
 )
 
 type OrderDTO struct {
-	ID        uuid.UUID   `gorm:"type:uuid;primaryKey"`
-	CourierID *uuid.UUID  `gorm:"type:uuid;index"`
-	Location  LocationDTO `gorm:"embedded;embeddedPrefix:location_"`
-	Volume    int
-	Status    order.Status `gorm:"type:varchar(20)"`
+	ID         uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	CourierID  *uuid.UUID  `gorm:"type:uuid;index"`
+	Location   LocationDTO `gorm:"embedded;embeddedPrefix:location_"`
+	Pickup     PickupDTO   `gorm:"embedded;embeddedPrefix:pickup_"`
+	Volume     int
+	Weight     int            `gorm:"not null;default:0"`
+	Handling   order.Handling `gorm:"type:varchar(20);not null;default:Ambient"`
+	Status     order.Status   `gorm:"type:varchar(20)"`
+	Priority   order.Priority `gorm:"type:varchar(20);not null;default:Standard"`
+	PromisedBy *time.Time
+	CreatedAt  time.Time `gorm:"index"`
+	// Подтверждение доставки кодом: хранится только хеш кода, пустой - подтверждение не требуется
+	ConfirmationCodeHash string `gorm:"type:varchar(64);not null;default:''"`
+	ConfirmationAttempts int    `gorm:"not null;default:0"`
+	ArrivedAt            *time.Time
+	ConfirmationOverdue  bool `gorm:"not null;default:false"`
+	// Неудачные попытки вручения и причина последней из них
+	FailureReason  order.FailureReason `gorm:"type:varchar(20);not null;default:''"`
+	FailedAttempts int                 `gorm:"not null;default:0"`
 }
 
 type LocationDTO struct {
-	X int
-	Y int
+	X   int
+	Y   int
+	Lat *float64
+	Lon *float64
+}
+
+// PickupDTO is nullable: orders created before the pickup leg have no pickup location.
+type PickupDTO struct {
+	X   *int
+	Y   *int
+	Lat *float64
+	Lon *float64
 }
 
 func (OrderDTO) TableName() string {
@@ -9,18 +9,65 @@ func DomainToDTO(aggregate *order.Order) OrderDTO {
 	var orderDTO OrderDTO
 	orderDTO.ID = aggregate.ID()
 	orderDTO.CourierID = aggregate.CourierID()
-	orderDTO.Location = LocationDTO{
-		X: aggregate.Location().X(),
-		Y: aggregate.Location().Y(),
+	orderDTO.Location = locationToDTO(aggregate.Location())
+	if aggregate.HasPickup() {
+		pickup := locationToDTO(aggregate.Pickup())
+		orderDTO.Pickup = PickupDTO{X: &pickup.X, Y: &pickup.Y, Lat: pickup.Lat, Lon: pickup.Lon}
 	}
 	orderDTO.Volume = aggregate.Volume()
+	orderDTO.Weight = aggregate.Weight()
+	orderDTO.Handling = aggregate.Handling()
 	orderDTO.Status = aggregate.Status()
+	orderDTO.Priority = aggregate.Priority()
+	orderDTO.PromisedBy = aggregate.PromisedBy()
+	orderDTO.CreatedAt = aggregate.CreatedAt()
+	confirmation := aggregate.Confirmation()
+	orderDTO.ConfirmationCodeHash = confirmation.CodeHash()
+	orderDTO.ConfirmationAttempts = confirmation.Attempts()
+	orderDTO.ArrivedAt = confirmation.ArrivedAt()
+	orderDTO.ConfirmationOverdue = confirmation.IsOverdue()
+	failure := aggregate.Failure()
+	orderDTO.FailureReason = failure.Reason()
+	orderDTO.FailedAttempts = failure.Attempts()
 	return orderDTO
 }
 
 func DtoToDomain(dto OrderDTO) *order.Order {
 	var aggregate *order.Order
-	location, _ := kernel.NewLocation(dto.Location.X, dto.Location.Y)
-	aggregate = order.RestoreOrder(dto.ID, dto.CourierID, location, dto.Volume, dto.Status)
+	location := locationFromDTO(dto.Location)
+	var pickup kernel.Location
+	if dto.Pickup.X != nil && dto.Pickup.Y != nil {
+		pickup = locationFromDTO(LocationDTO{X: *dto.Pickup.X, Y: *dto.Pickup.Y, Lat: dto.Pickup.Lat, Lon: dto.Pickup.Lon})
+	}
+	priority := dto.Priority
+	if priority.IsEmpty() {
+		priority = order.PriorityStandard
+	}
+	handling := dto.Handling
+	if handling.IsEmpty() {
+		handling = order.HandlingAmbient
+	}
+	confirmation := order.RestoreConfirmation(dto.ConfirmationCodeHash, dto.ConfirmationAttempts, dto.ArrivedAt, dto.ConfirmationOverdue)
+	failure := order.RestoreDeliveryFailure(dto.FailureReason, dto.FailedAttempts)
+	aggregate = order.RestoreOrder(dto.ID, dto.CourierID, pickup, location, dto.Volume, dto.Weight, handling, dto.Status, priority,
+		dto.PromisedBy, dto.CreatedAt, confirmation, failure)
 	return aggregate
 }
+
+func locationToDTO(location kernel.Location) LocationDTO {
+	dto := LocationDTO{X: location.X(), Y: location.Y()}
+	if location.IsGeographic() {
+		lat, lon := location.Point().Lat(), location.Point().Lon()
+		dto.Lat, dto.Lon = &lat, &lon
+	}
+	return dto
+}
+
+func locationFromDTO(dto LocationDTO) kernel.Location {
+	if dto.Lat != nil && dto.Lon != nil {
+		if point, err := kernel.NewGeoPoint(*dto.Lat, *dto.Lon); err == nil {
+			return kernel.RestoreGeoLocation(dto.X, dto.Y, point)
+		}
+	}
+	return kernel.RestoreLocation(dto.X, dto.Y)
+}
@@ -2,7 +2,7 @@ package orderrepo
 
 import (
 	"context"
-	"errors"
+	"time"
 
 	"delivery/internal/adapters/out/postgres/shared"
 	"delivery/internal/core/domain/model/order"
@@ -90,38 +90,92 @@ func (r *Repository) Get(ctx context.Context, ID uuid.UUID) (*order.Order, error
 	return aggregate, nil
 }
 
-func (r *Repository) GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error) {
-	var dto OrderDTO
+// GetAllInCreatedStatus returns the orders waiting for a courier in dispatch order:
+// express first, then by age.
+func (r *Repository) GetAllInCreatedStatus(ctx context.Context) ([]*order.Order, error) {
+	var dtos []OrderDTO
 
 	tx := r.getTxOrDb()
 	result := tx.WithContext(ctx).
 		Preload(clause.Associations).
 		Where("status = ?", order.StatusCreated).
-		First(&dto)
+		// Сначала срочные, затем по возрасту. У старых заказов created_at не заполнен, они самые давние
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "CASE priority WHEN ? THEN 0 ELSE 1 END, created_at NULLS FIRST",
+			Vars: []any{order.PriorityExpress},
+		}}).
+		Find(&dtos)
 	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, errs.NewObjectNotFoundError("Free courier", nil)
-		}
 		return nil, result.Error
 	}
+	if result.RowsAffected == 0 {
+		return nil, errs.NewObjectNotFoundError("Orders in created status", nil)
+	}
 
-	aggregate := DtoToDomain(dto)
-	return aggregate, nil
+	aggregates := make([]*order.Order, len(dtos))
+	for i, dto := range dtos {
+		aggregates[i] = DtoToDomain(dto)
+	}
+
+	return aggregates, nil
 }
 
-func (r *Repository) GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error) {
+// GetAllWithCouriers returns the orders being delivered: assigned and picked up.
+func (r *Repository) GetAllWithCouriers(ctx context.Context) ([]*order.Order, error) {
 	var dtos []OrderDTO
 
 	tx := r.getTxOrDb()
 	result := tx.WithContext(ctx).
 		Preload(clause.Associations).
-		Where("status = ?", order.StatusAssigned).
+		Where("status IN ?", []order.Status{order.StatusAssigned, order.StatusPickedUp}).
 		Find(&dtos)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	if result.RowsAffected == 0 {
-		return nil, errs.NewObjectNotFoundError("Assigned orders", nil)
+		return nil, errs.NewObjectNotFoundError("Orders with couriers", nil)
+	}
+
+	aggregates := make([]*order.Order, len(dtos))
+	for i, dto := range dtos {
+		aggregates[i] = DtoToDomain(dto)
+	}
+
+	return aggregates, nil
+}
+
+// GetAllAwaitingConfirmation returns the not yet flagged orders whose courier arrived
+// before arrivedBefore and still waits for the code. No orders is not an error.
+func (r *Repository) GetAllAwaitingConfirmation(ctx context.Context, arrivedBefore time.Time) ([]*order.Order, error) {
+	var dtos []OrderDTO
+
+	tx := r.getTxOrDb()
+	result := tx.WithContext(ctx).
+		Where("status = ? AND confirmation_overdue = ? AND arrived_at < ?",
+			order.StatusAwaitingConfirmation, false, arrivedBefore).
+		Find(&dtos)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	aggregates := make([]*order.Order, len(dtos))
+	for i, dto := range dtos {
+		aggregates[i] = DtoToDomain(dto)
+	}
+
+	return aggregates, nil
+}
+
+// GetAllFailed returns the orders waiting for a decision on redelivery. No orders is not an error.
+func (r *Repository) GetAllFailed(ctx context.Context) ([]*order.Order, error) {
+	var dtos []OrderDTO
+
+	tx := r.getTxOrDb()
+	result := tx.WithContext(ctx).
+		Where("status = ?", order.StatusFailed).
+		Find(&dtos)
+	if result.Error != nil {
+		return nil, result.Error
 	}
 
 	aggregates := make([]*order.Order, len(dtos))
@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// keySize selects AES-256.
+const keySize = 32
+
+// Cipher encrypts secrets of events kept in the outbox, see ddd.SecretEvent.
+// A secret is bound to its message, so it cannot be moved to another one.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher takes the hex encoded 32 byte key.
+func NewCipher(key string) (*Cipher, error) {
+	if key == "" {
+		return nil, errs.NewValueIsRequiredError("key")
+	}
+	raw, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, errs.NewValueIsInvalidErrorWithCause("key", err)
+	}
+	if len(raw) != keySize {
+		return nil, errs.NewValueIsInvalidError("key")
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+func (c *Cipher) Seal(messageID uuid.UUID, secret string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(secret), messageID[:])
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *Cipher) Open(messageID uuid.UUID, sealed string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", errs.NewValueIsInvalidErrorWithCause("secret", err)
+	}
+	if len(raw) < c.aead.NonceSize() {
+		return "", errs.NewValueIsInvalidError("secret")
+	}
+
+	nonce, ciphertext := raw[:c.aead.NonceSize()], raw[c.aead.NonceSize():]
+	secret, err := c.aead.Open(nil, nonce, ciphertext, messageID[:])
+	if err != nil {
+		return "", errs.NewValueIsInvalidErrorWithCause("secret", err)
+	}
+	return string(secret), nil
+}
@@ -0,0 +1,94 @@
+package outbox
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const testKey = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+
+type testSecretEvent struct {
+	ID     uuid.UUID
+	Secret string `json:"-"`
+}
+
+func (e testSecretEvent) GetID() uuid.UUID { return e.ID }
+
+func (e testSecretEvent) GetName() string { return "test.secret" }
+
+func (e testSecretEvent) GetSecret() string { return e.Secret }
+
+func (e testSecretEvent) WithSecret(secret string) ddd.DomainEvent {
+	e.Secret = secret
+	return e
+}
+
+func TestNewCipher(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewCipher("")
+	assert.ErrorIs(err, errs.ErrValueIsRequired)
+	_, err = NewCipher("not hex")
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+	_, err = NewCipher("0011")
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+	_, err = NewCipher(testKey)
+	assert.NoError(err)
+}
+
+func TestCipher_SealAndOpen(t *testing.T) {
+	assert := assert.New(t)
+
+	cipher, err := NewCipher(testKey)
+	assert.NoError(err)
+
+	messageID := uuid.New()
+	sealed, err := cipher.Seal(messageID, "1234")
+	assert.NoError(err)
+	assert.NotContains(sealed, "1234")
+
+	secret, err := cipher.Open(messageID, sealed)
+	assert.NoError(err)
+	assert.Equal("1234", secret)
+
+	// Секрет привязан к сообщению и к ключу
+	_, err = cipher.Open(uuid.New(), sealed)
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+	other, err := NewCipher(strings.Repeat("ff", keySize))
+	assert.NoError(err)
+	_, err = other.Open(messageID, sealed)
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+}
+
+func TestDomainToDTO_Secret(t *testing.T) {
+	assert := assert.New(t)
+
+	registry := NewEventRegistry()
+	registry.Register(testSecretEvent{})
+	cipher, err := NewCipher(testKey)
+	assert.NoError(err)
+
+	event := testSecretEvent{ID: uuid.New(), Secret: "1234"}
+
+	// Без ключа секрет не сохраняется
+	_, err = DomainToDTO(event, nil)
+	assert.ErrorIs(err, errs.ErrValueIsRequired)
+
+	dto, err := DomainToDTO(event, cipher)
+	assert.NoError(err)
+	assert.NotContains(dto.Payload, "1234")
+	assert.NotEmpty(dto.Secret)
+
+	got, err := DtoToDomain(dto, registry, cipher)
+	assert.NoError(err)
+	assert.Equal(event, got)
+
+	_, err = DtoToDomain(dto, registry, nil)
+	assert.ErrorIs(err, errs.ErrValueIsRequired)
+}
@@ -0,0 +1,24 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageDTO struct {
+	ID               uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	Name             string     `gorm:"type:varchar(255);not null"`
+	Payload          string     `gorm:"type:jsonb;not null"`
+	OccurredAtUtc    time.Time  `gorm:"not null"`
+	ProcessedAtUtc   *time.Time `gorm:"index"`
+	Attempts         int
+	NextAttemptAtUtc *time.Time
+	LastError        string
+	// Secret is the encrypted secret of the event, it is erased once the message is processed.
+	Secret string `gorm:"type:text"`
+}
+
+func (MessageDTO) TableName() string {
+	return "outbox"
+}
@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+)
+
+// DomainToDTO encrypts the secret of the event with cipher, the cipher is only required
+// for events with a secret.
+func DomainToDTO(event ddd.DomainEvent, cipher *Cipher) (MessageDTO, error) {
+	if event == nil {
+		return MessageDTO{}, errs.NewValueIsRequiredError("event")
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return MessageDTO{}, errs.NewValueIsInvalidErrorWithCause("event", err)
+	}
+
+	dto := MessageDTO{
+		ID:            event.GetID(),
+		Name:          event.GetName(),
+		Payload:       string(payload),
+		OccurredAtUtc: time.Now().UTC(),
+	}
+
+	if secretEvent, ok := event.(ddd.SecretEvent); ok && secretEvent.GetSecret() != "" {
+		if cipher == nil {
+			return MessageDTO{}, errs.NewValueIsRequiredError("cipher")
+		}
+		if dto.Secret, err = cipher.Seal(dto.ID, secretEvent.GetSecret()); err != nil {
+			return MessageDTO{}, err
+		}
+	}
+	return dto, nil
+}
+
+func DtoToDomain(dto MessageDTO, registry *EventRegistry, cipher *Cipher) (ddd.DomainEvent, error) {
+	if registry == nil {
+		return nil, errs.NewValueIsRequiredError("registry")
+	}
+	event, err := registry.Decode(dto.Name, []byte(dto.Payload))
+	if err != nil || dto.Secret == "" {
+		return event, err
+	}
+
+	secretEvent, ok := event.(ddd.SecretEvent)
+	if !ok {
+		return nil, errs.NewValueIsInvalidError("secret")
+	}
+	if cipher == nil {
+		return nil, errs.NewValueIsRequiredError("cipher")
+	}
+	secret, err := cipher.Open(dto.ID, dto.Secret)
+	if err != nil {
+		return nil, err
+	}
+	return secretEvent.WithSecret(secret), nil
+}
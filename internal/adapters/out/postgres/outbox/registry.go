@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+)
+
+// EventRegistry restores domain events from outbox messages by their names.
+type EventRegistry struct {
+	types map[string]reflect.Type
+}
+
+func NewEventRegistry() *EventRegistry {
+	return &EventRegistry{types: make(map[string]reflect.Type)}
+}
+
+func (r *EventRegistry) Register(events ...ddd.DomainEvent) {
+	for _, event := range events {
+		r.types[event.GetName()] = reflect.TypeOf(event)
+	}
+}
+
+func (r *EventRegistry) Decode(name string, payload []byte) (ddd.DomainEvent, error) {
+	typ, ok := r.types[name]
+	if !ok {
+		return nil, errs.NewObjectNotFoundError("event", name)
+	}
+
+	if typ.Kind() == reflect.Pointer {
+		event := reflect.New(typ.Elem())
+		if err := json.Unmarshal(payload, event.Interface()); err != nil {
+			return nil, errs.NewValueIsInvalidErrorWithCause("payload", err)
+		}
+		return event.Interface().(ddd.DomainEvent), nil
+	}
+
+	event := reflect.New(typ)
+	if err := json.Unmarshal(payload, event.Interface()); err != nil {
+		return nil, errs.NewValueIsInvalidErrorWithCause("payload", err)
+	}
+	return event.Elem().Interface().(ddd.DomainEvent), nil
+}
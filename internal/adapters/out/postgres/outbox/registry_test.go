@@ -0,0 +1,84 @@
+package outbox
+
+import (
+	"testing"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDomainEvent struct {
+	ID      uuid.UUID
+	OrderID uuid.UUID
+}
+
+func (e testDomainEvent) GetID() uuid.UUID { return e.ID }
+
+func (e testDomainEvent) GetName() string { return "test.event" }
+
+type testPointerEvent struct {
+	ID uuid.UUID
+}
+
+func (e *testPointerEvent) GetID() uuid.UUID { return e.ID }
+
+func (e *testPointerEvent) GetName() string { return "test.pointer" }
+
+func TestEventRegistry_Decode(t *testing.T) {
+	assert := assert.New(t)
+
+	registry := NewEventRegistry()
+	registry.Register(testDomainEvent{}, &testPointerEvent{})
+
+	value := testDomainEvent{ID: uuid.New(), OrderID: uuid.New()}
+	pointer := &testPointerEvent{ID: uuid.New()}
+
+	tests := []struct {
+		name    string
+		message MessageDTO
+		want    any
+		wantErr error
+	}{
+		{
+			name: "good value",
+			message: func() MessageDTO {
+				dto, err := DomainToDTO(value, nil)
+				assert.NoError(err)
+				return dto
+			}(),
+			want: value,
+		},
+		{
+			name: "good pointer",
+			message: func() MessageDTO {
+				dto, err := DomainToDTO(pointer, nil)
+				assert.NoError(err)
+				return dto
+			}(),
+			want: pointer,
+		},
+		{
+			name:    "bad unknown event",
+			message: MessageDTO{Name: "unknown", Payload: "{}"},
+			wantErr: errs.ErrObjectNotFound,
+		},
+		{
+			name:    "bad payload",
+			message: MessageDTO{Name: value.GetName(), Payload: "not json"},
+			wantErr: errs.ErrValueIsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DtoToDomain(tt.message, registry, nil)
+			if err != nil {
+				assert.ErrorIs(err, tt.wantErr)
+			} else {
+				assert.Equal(tt.want, got)
+			}
+		})
+	}
+}
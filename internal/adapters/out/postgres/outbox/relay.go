@@ -0,0 +1,135 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/labstack/gommon/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	batchSize     = 100
+	minRetryDelay = time.Second
+	maxRetryDelay = 5 * time.Minute
+)
+
+// Relay publishes unprocessed outbox messages to every publisher and marks them as processed.
+// A message is delivered at least once, so publishers must tolerate duplicates.
+type Relay struct {
+	db         *gorm.DB
+	registry   *EventRegistry
+	cipher     *Cipher
+	publishers []ports.EventPublisher
+}
+
+func NewRelay(db *gorm.DB, registry *EventRegistry, publishers ...ports.EventPublisher) (*Relay, error) {
+	return NewRelayWithCipher(db, registry, nil, publishers...)
+}
+
+// NewRelayWithCipher makes a relay which decrypts secrets of events, see DomainToDTO.
+func NewRelayWithCipher(db *gorm.DB, registry *EventRegistry, cipher *Cipher, publishers ...ports.EventPublisher) (*Relay, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+	if registry == nil {
+		return nil, errs.NewValueIsRequiredError("registry")
+	}
+	if len(publishers) == 0 {
+		return nil, errs.NewValueIsRequiredError("publishers")
+	}
+	for _, publisher := range publishers {
+		if publisher == nil {
+			return nil, errs.NewValueIsRequiredError("publisher")
+		}
+	}
+	return &Relay{db: db, registry: registry, cipher: cipher, publishers: publishers}, nil
+}
+
+// Process publishes the next batch of messages which are due.
+// Failed messages are rescheduled with an exponential backoff.
+func (r *Relay) Process(ctx context.Context) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+
+		var messages []MessageDTO
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("processed_at_utc IS NULL").
+			Where("next_attempt_at_utc IS NULL OR next_attempt_at_utc <= ?", now).
+			Order("occurred_at_utc").
+			Limit(batchSize).
+			Find(&messages).
+			Error
+		if err != nil {
+			return err
+		}
+
+		for i := range messages {
+			r.publish(ctx, &messages[i], now)
+			if err = tx.Save(&messages[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Purge deletes messages processed earlier than retention ago.
+func (r *Relay) Purge(ctx context.Context, retention time.Duration) error {
+	return r.db.WithContext(ctx).
+		Where("processed_at_utc < ?", time.Now().UTC().Add(-retention)).
+		Delete(&MessageDTO{}).
+		Error
+}
+
+func (r *Relay) publish(ctx context.Context, message *MessageDTO, now time.Time) {
+	event, err := DtoToDomain(*message, r.registry, r.cipher)
+	if err != nil {
+		// Сообщение не может быть восстановлено, повторять бесполезно
+		log.Errorf("outbox message %s (%s): %v", message.ID, message.Name, err)
+		message.LastError = err.Error()
+		message.ProcessedAtUtc = &now
+		message.Secret = ""
+		return
+	}
+
+	if err = r.publishToAll(ctx, event); err != nil {
+		log.Errorf("publish outbox message %s: %v", message.ID, err)
+		message.Attempts++
+		message.LastError = err.Error()
+		nextAttemptAt := now.Add(retryDelay(message.Attempts))
+		message.NextAttemptAtUtc = &nextAttemptAt
+		return
+	}
+
+	message.LastError = ""
+	message.ProcessedAtUtc = &now
+	message.Secret = ""
+}
+
+// publishToAll stops at the first failure, the whole message is retried later.
+func (r *Relay) publishToAll(ctx context.Context, event ddd.DomainEvent) error {
+	for _, publisher := range r.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func retryDelay(attempts int) time.Duration {
+	if attempts <= 0 {
+		return 0
+	}
+
+	delay := minRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,149 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/inmemory"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/testcnts"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	postgresgorm "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func Test_RelayShouldPublishAndPurgeMessages(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	registry := NewEventRegistry()
+	registry.Register(testDomainEvent{})
+	publisher := inmemory.NewEventPublisher()
+
+	relay, err := NewRelay(db, registry, publisher)
+	assert.NoError(err)
+
+	event := testDomainEvent{ID: uuid.New(), OrderID: uuid.New()}
+	message, err := DomainToDTO(event, nil)
+	assert.NoError(err)
+	assert.NoError(db.Create(&message).Error)
+
+	// Публикуем
+	assert.NoError(relay.Process(ctx))
+	assert.Equal([]ddd.DomainEvent{event}, publisher.Events())
+
+	var dto MessageDTO
+	assert.NoError(db.First(&dto, "id = ?", event.ID).Error)
+	assert.NotNil(dto.ProcessedAtUtc)
+
+	// Повторно не публикуем
+	assert.NoError(relay.Process(ctx))
+	assert.Len(publisher.Events(), 1)
+
+	// Удаляем обработанные
+	assert.NoError(relay.Purge(ctx, 0))
+	var count int64
+	assert.NoError(db.Model(&MessageDTO{}).Count(&count).Error)
+	assert.Zero(count)
+}
+
+func Test_RelayShouldRecordUnknownEvents(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	publisher := inmemory.NewEventPublisher()
+	relay, err := NewRelay(db, NewEventRegistry(), publisher)
+	assert.NoError(err)
+
+	event := testDomainEvent{ID: uuid.New(), OrderID: uuid.New()}
+	message, err := DomainToDTO(event, nil)
+	assert.NoError(err)
+	assert.NoError(db.Create(&message).Error)
+
+	// Незарегистрированное событие не публикуется, но причина сохраняется
+	assert.NoError(relay.Process(ctx))
+	assert.Empty(publisher.Events())
+
+	var dto MessageDTO
+	assert.NoError(db.First(&dto, "id = ?", event.ID).Error)
+	assert.NotNil(dto.ProcessedAtUtc)
+	assert.NotEmpty(dto.LastError)
+}
+
+func Test_RelayShouldDecryptAndEraseSecrets(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	registry := NewEventRegistry()
+	registry.Register(testSecretEvent{})
+	cipher, err := NewCipher(testKey)
+	assert.NoError(err)
+	publisher := inmemory.NewEventPublisher()
+
+	relay, err := NewRelayWithCipher(db, registry, cipher, publisher)
+	assert.NoError(err)
+
+	event := testSecretEvent{ID: uuid.New(), Secret: "1234"}
+	message, err := DomainToDTO(event, cipher)
+	assert.NoError(err)
+	assert.NoError(db.Create(&message).Error)
+
+	// Обработчик получает секрет, в outbox он больше не хранится
+	assert.NoError(relay.Process(ctx))
+	assert.Equal([]ddd.DomainEvent{event}, publisher.Events())
+
+	var dto MessageDTO
+	assert.NoError(db.First(&dto, "id = ?", event.ID).Error)
+	assert.NotNil(dto.ProcessedAtUtc)
+	assert.Empty(dto.Secret)
+}
+
+func Test_retryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: 0},
+		{attempts: 1, want: time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 100, want: maxRetryDelay},
+	}
+
+	assert := assert.New(t)
+	for _, tt := range tests {
+		assert.Equal(tt.want, retryDelay(tt.attempts))
+	}
+}
+
+func setupTest(t *testing.T) (context.Context, *gorm.DB, error) {
+	ctx := context.Background()
+	postgresContainer, dsn, err := testcnts.StartPostgresContainer(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Подключаемся к БД через Gorm
+	db, err := gorm.Open(postgresgorm.Open(dsn), &gorm.Config{})
+	assert.NoError(t, err)
+
+	// Авто миграция (создаём таблицу)
+	err = db.AutoMigrate(&MessageDTO{})
+	assert.NoError(t, err)
+
+	// Очистка выполняется после завершения теста
+	t.Cleanup(func() {
+		err := postgresContainer.Terminate(ctx)
+		assert.NoError(t, err)
+	})
+
+	return ctx, db, nil
+}
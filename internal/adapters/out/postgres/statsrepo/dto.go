@@ -0,0 +1,49 @@
+package statsrepo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CourierStatsDTO struct {
+	CourierID       uuid.UUID     `gorm:"type:uuid;primaryKey"`
+	DeliveredOrders int           `gorm:"not null;default:0"`
+	Distance        int           `gorm:"not null;default:0"`
+	DeliveryTime    time.Duration `gorm:"not null;default:0"`
+	// TimedDeliveries counts deliveries with a known delivery time, DeliveryTime is their sum.
+	TimedDeliveries int `gorm:"not null;default:0"`
+}
+
+func (CourierStatsDTO) TableName() string {
+	return "courier_stats"
+}
+
+// AppliedEventDTO is the event already counted in the stats, so a redelivered event is skipped.
+type AppliedEventDTO struct {
+	EventID      uuid.UUID `gorm:"type:uuid;primaryKey"`
+	AppliedAtUtc time.Time `gorm:"not null"`
+}
+
+func (AppliedEventDTO) TableName() string {
+	return "courier_stats_events"
+}
+
+// RatingDTO is the score of one delivered order, so the order cannot be rated twice.
+type RatingDTO struct {
+	OrderID      uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CourierID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	Score        int       `gorm:"not null"`
+	CreatedAtUtc time.Time `gorm:"not null"`
+}
+
+func (RatingDTO) TableName() string {
+	return "courier_ratings"
+}
+
+// ratingSummaryDTO is the aggregate of the courier ratings.
+type ratingSummaryDTO struct {
+	CourierID uuid.UUID
+	Sum       int
+	Count     int
+}
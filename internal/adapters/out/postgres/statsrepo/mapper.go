@@ -0,0 +1,19 @@
+package statsrepo
+
+import (
+	"delivery/internal/core/domain/model/courier"
+
+	"github.com/google/uuid"
+)
+
+func DtoToDomain(courierID uuid.UUID, stats CourierStatsDTO, rating ratingSummaryDTO) courier.Stats {
+	return courier.RestoreStats(
+		courierID,
+		stats.DeliveredOrders,
+		stats.Distance,
+		stats.DeliveryTime,
+		stats.TimedDeliveries,
+		rating.Sum,
+		rating.Count,
+	)
+}
@@ -0,0 +1,169 @@
+package statsrepo
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ ports.CourierStatsRepository = &Repository{}
+
+// Repository updates counters with single upserts, so concurrent handlers do not lose increments.
+// Every increment is recorded with the ID of its event in the same transaction,
+// so an event delivered again by the outbox is counted once.
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*Repository, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) AddDelivery(ctx context.Context, eventID uuid.UUID, courierID uuid.UUID, deliveryTime time.Duration) error {
+	if eventID == uuid.Nil {
+		return errs.NewValueIsRequiredError("eventID")
+	}
+	if courierID == uuid.Nil {
+		return errs.NewValueIsRequiredError("courierID")
+	}
+
+	dto := CourierStatsDTO{CourierID: courierID, DeliveredOrders: 1}
+	updates := map[string]any{
+		"delivered_orders": gorm.Expr("courier_stats.delivered_orders + 1"),
+	}
+	// Время доставки старых заказов неизвестно, в среднее оно не попадает
+	if deliveryTime > 0 {
+		dto.DeliveryTime = deliveryTime
+		dto.TimedDeliveries = 1
+		updates["delivery_time"] = gorm.Expr("courier_stats.delivery_time + ?", deliveryTime)
+		updates["timed_deliveries"] = gorm.Expr("courier_stats.timed_deliveries + 1")
+	}
+	return r.apply(ctx, eventID, dto, updates)
+}
+
+func (r *Repository) AddDistance(ctx context.Context, eventID uuid.UUID, courierID uuid.UUID, distance int) error {
+	if eventID == uuid.Nil {
+		return errs.NewValueIsRequiredError("eventID")
+	}
+	if courierID == uuid.Nil {
+		return errs.NewValueIsRequiredError("courierID")
+	}
+	if distance == 0 {
+		return nil
+	}
+
+	dto := CourierStatsDTO{CourierID: courierID, Distance: distance}
+	return r.apply(ctx, eventID, dto, map[string]any{
+		"distance": gorm.Expr("courier_stats.distance + ?", distance),
+	})
+}
+
+// apply upserts the counters unless the event has already been applied.
+func (r *Repository) apply(ctx context.Context, eventID uuid.UUID, dto CourierStatsDTO, updates map[string]any) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		applied := AppliedEventDTO{EventID: eventID, AppliedAtUtc: time.Now().UTC()}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&applied)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "courier_id"}},
+			DoUpdates: clause.Assignments(updates),
+		}).
+			Create(&dto).
+			Error
+	})
+}
+
+func (r *Repository) AddRating(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID, score int) error {
+	if orderID == uuid.Nil {
+		return errs.NewValueIsRequiredError("orderID")
+	}
+	if courierID == uuid.Nil {
+		return errs.NewValueIsRequiredError("courierID")
+	}
+	if err := courier.ValidateRating(score); err != nil {
+		return err
+	}
+
+	dto := RatingDTO{
+		OrderID:      orderID,
+		CourierID:    courierID,
+		Score:        score,
+		CreatedAtUtc: time.Now().UTC(),
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&dto)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ports.ErrOrderAlreadyRated
+	}
+	return nil
+}
+
+// Get returns empty stats for the courier who has not delivered or travelled yet.
+func (r *Repository) Get(ctx context.Context, courierID uuid.UUID) (courier.Stats, error) {
+	all, err := r.GetAll(ctx, []uuid.UUID{courierID})
+	if err != nil {
+		return courier.Stats{}, err
+	}
+	return all[courierID], nil
+}
+
+func (r *Repository) GetAll(ctx context.Context, courierIDs []uuid.UUID) (map[uuid.UUID]courier.Stats, error) {
+	result := make(map[uuid.UUID]courier.Stats, len(courierIDs))
+	if len(courierIDs) == 0 {
+		return result, nil
+	}
+
+	var stats []CourierStatsDTO
+	err := r.db.WithContext(ctx).
+		Where("courier_id IN ?", courierIDs).
+		Find(&stats).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	var ratings []ratingSummaryDTO
+	err = r.db.WithContext(ctx).
+		Model(&RatingDTO{}).
+		Select("courier_id, SUM(score) AS sum, COUNT(*) AS count").
+		Where("courier_id IN ?", courierIDs).
+		Group("courier_id").
+		Scan(&ratings).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	statsByCourier := make(map[uuid.UUID]CourierStatsDTO, len(stats))
+	for _, dto := range stats {
+		statsByCourier[dto.CourierID] = dto
+	}
+	ratingsByCourier := make(map[uuid.UUID]ratingSummaryDTO, len(ratings))
+	for _, dto := range ratings {
+		ratingsByCourier[dto.CourierID] = dto
+	}
+
+	for _, courierID := range courierIDs {
+		result[courierID] = DtoToDomain(courierID, statsByCourier[courierID], ratingsByCourier[courierID])
+	}
+	return result, nil
+}
@@ -3,9 +3,12 @@ package postgres
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/inbox"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outbox"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
@@ -14,37 +17,66 @@ import (
 	"gorm.io/gorm"
 )
 
-func NewUnitOfWorkFactory(db *gorm.DB) (ports.UnitOfWorkFactory, error) {
+func NewUnitOfWorkFactory(db *gorm.DB, mediatr ddd.Mediatr) (ports.UnitOfWorkFactory, error) {
+	return NewUnitOfWorkFactoryWithCipher(db, mediatr, nil)
+}
+
+// NewUnitOfWorkFactoryWithCipher makes units of work which encrypt secrets of events
+// in the outbox, see outbox.DomainToDTO. Without the cipher events with a secret cannot be saved.
+func NewUnitOfWorkFactoryWithCipher(db *gorm.DB, mediatr ddd.Mediatr, cipher *outbox.Cipher) (ports.UnitOfWorkFactory, error) {
 	if db == nil {
 		return nil, errs.NewValueIsRequiredError("db")
 	}
-	return &unitOfWorkFactory{db: db}, nil
+	if mediatr == nil {
+		return nil, errs.NewValueIsRequiredError("mediatr")
+	}
+	return &unitOfWorkFactory{db: db, mediatr: mediatr, cipher: cipher}, nil
 }
 
 type unitOfWorkFactory struct {
-	db *gorm.DB
+	db      *gorm.DB
+	mediatr ddd.Mediatr
+	cipher  *outbox.Cipher
 }
 
 func (f *unitOfWorkFactory) New(ctx context.Context) (ports.UnitOfWork, error) {
-	return NewUnitOfWork(f.db.WithContext(ctx))
+	uow, err := newUnitOfWork(f.db.WithContext(ctx), f.mediatr, f.cipher)
+	if err != nil {
+		return nil, err
+	}
+	return uow, nil
 }
 
 type UnitOfWork struct {
 	tx                *gorm.DB
 	db                *gorm.DB
+	mediatr           ddd.Mediatr
+	cipher            *outbox.Cipher
 	committed         bool
 	trackedAggregates []ddd.AggregateRoot
 	//
 	orderRepository   ports.OrderRepository
 	courierRepository ports.CourierRepository
+	inboxRepository   ports.InboxRepository
 }
 
-func NewUnitOfWork(db *gorm.DB) (ports.UnitOfWork, error) {
+func NewUnitOfWork(db *gorm.DB, mediatr ddd.Mediatr) (ports.UnitOfWork, error) {
+	uow, err := newUnitOfWork(db, mediatr, nil)
+	if err != nil {
+		return nil, err
+	}
+	return uow, nil
+}
+
+func newUnitOfWork(db *gorm.DB, mediatr ddd.Mediatr, cipher *outbox.Cipher) (*UnitOfWork, error) {
 	if db == nil {
 		return nil, errs.NewValueIsRequiredError("db")
 	}
+	if mediatr == nil {
+		return nil, errs.NewValueIsRequiredError("mediatr")
+	}
 
-	uow := &UnitOfWork{db: db}
+	uow := &UnitOfWork{db: db, mediatr: mediatr, cipher: cipher}
 
 	orderRepo, err := orderrepo.NewRepository(uow)
 	if err != nil {
@@ -58,6 +90,12 @@ func NewUnitOfWork(db *gorm.DB) (ports.UnitOfWork, error) {
 	}
 	uow.courierRepository = courierRepo
 
+	inboxRepo, err := inbox.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.inboxRepository = inboxRepo
+
 	return uow, nil
 }
 
@@ -71,6 +109,10 @@ func (u *UnitOfWork) OrderRepository() ports.OrderRepository {
 	return u.orderRepository
 }
 
+func (u *UnitOfWork) InboxRepository() ports.InboxRepository {
+	return u.inboxRepository
+}
+
 func (u *UnitOfWork) Tx() *gorm.DB {
 	return u.tx
 }
@@ -84,6 +126,9 @@ func (u *UnitOfWork) InTx() bool {
 }
 
 func (u *UnitOfWork) Track(agg ddd.AggregateRoot) {
+	if slices.Contains(u.trackedAggregates, agg) {
+		return
+	}
 	u.trackedAggregates = append(u.trackedAggregates, agg)
 }
 
@@ -97,12 +142,24 @@ func (u *UnitOfWork) Commit(ctx context.Context) error {
 		return errs.NewValueIsRequiredError("cannot commit without transaction")
 	}
 
+	if err := u.saveDomainEvents(ctx); err != nil {
+		return err
+	}
+
 	if err := u.tx.WithContext(ctx).Commit().Error; err != nil {
 		return err
 	}
 
+	var events []ddd.DomainEvent
+	for _, agg := range u.trackedAggregates {
+		events = append(events, agg.GetDomainEvents()...)
+		agg.ClearDomainEvents()
+	}
+
 	u.committed = true
 	u.clearTx()
+
+	u.publishDomainEvents(ctx, events)
 	return nil
 }
 
@@ -115,6 +172,38 @@ func (u *UnitOfWork) RollbackUnlessCommitted(ctx context.Context) {
 	}
 }
 
+// saveDomainEvents writes events of the tracked aggregates to the outbox
+// in the current transaction, so they are persisted together with the state.
+func (u *UnitOfWork) saveDomainEvents(ctx context.Context) error {
+	var messages []outbox.MessageDTO
+	for _, agg := range u.trackedAggregates {
+		for _, event := range agg.GetDomainEvents() {
+			message, err := outbox.DomainToDTO(event, u.cipher)
+			if err != nil {
+				return err
+			}
+			messages = append(messages, message)
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return u.tx.WithContext(ctx).Create(&messages).Error
+}
+
+// publishDomainEvents dispatches already committed events to in-process handlers.
+// The work is already done at this point, so failures are only logged and
+// the caller is not asked to retry it.
+func (u *UnitOfWork) publishDomainEvents(ctx context.Context, events []ddd.DomainEvent) {
+	for _, event := range events {
+		if err := u.mediatr.Publish(ctx, event); err != nil {
+			log.Errorf("publish domain event %s %s: %v", event.GetName(), event.GetID(), err)
+		}
+	}
+}
+
 func (u *UnitOfWork) clearTx() {
 	u.tx = nil
 	u.trackedAggregates = nil
@@ -2,13 +2,20 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/inbox"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outbox"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
 	"delivery/internal/pkg/testcnts"
 
 	"github.com/google/uuid"
@@ -25,14 +32,14 @@ func Test_CourierRepositoryShouldCanAddCourier(t *testing.T) {
 	assert.NoError(err)
 
 	// Создаем UnitOfWork
-	factory, err := NewUnitOfWorkFactory(db)
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
 	assert.NoError(err)
 
 	// Вызываем Add
 	name := "test"
 	speed := 5
 	loc := kernel.NewRandomLocation()
-	courier, err := courier.NewCourier(name, speed, loc)
+	courier, err := courier.NewCourier(name, courier.TransportTypePedestrian, speed, loc)
 	assert.NoError(err)
 
 	uow, err := factory.New(ctx)
@@ -58,6 +65,121 @@ func Test_CourierRepositoryShouldCanAddCourier(t *testing.T) {
 	assert.Equal(len(courier.StoragePlaces()), len(dto.StoragePlaces))
 }
 
+func Test_CourierRepositoryGetAllFreeShouldSkipUnavailable(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+
+	online, err := courier.NewCourier("online", courier.TransportTypePedestrian, 1, kernel.NewRandomLocation())
+	assert.NoError(err)
+	offline, err := courier.NewCourier("offline", courier.TransportTypePedestrian, 1, kernel.NewRandomLocation())
+	assert.NoError(err)
+	assert.NoError(offline.GoOffline())
+	resting, err := courier.NewCourier("resting", courier.TransportTypePedestrian, 1, kernel.NewRandomLocation())
+	assert.NoError(err)
+	assert.NoError(resting.StartBreak())
+
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	for _, c := range []*courier.Courier{online, offline, resting} {
+		assert.NoError(uow.CourierRepository().Add(ctx, c))
+	}
+	assert.NoError(uow.Commit(ctx))
+
+	// Свободен только курьер на линии
+	free, err := uow.CourierRepository().GetAllFree(ctx)
+	assert.NoError(err)
+	assert.Len(free, 1)
+	assert.True(online.Equal(free[0]))
+
+	// Статус восстанавливается из БД
+	got, err := uow.CourierRepository().Get(ctx, resting.ID())
+	assert.NoError(err)
+	assert.Equal(courier.AvailabilityOnBreak, got.Availability())
+}
+
+func Test_CourierRepositoryShouldKeepSeveralOrdersInStoragePlace(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+
+	walker, err := courier.NewCourier("walker", courier.TransportTypePedestrian, 1, kernel.NewRandomLocation())
+	assert.NoError(err)
+	first, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 2)
+	assert.NoError(err)
+	second, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 3)
+	assert.NoError(err)
+	assert.NoError(walker.TakeOrder(first))
+	assert.NoError(walker.TakeOrder(second))
+
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, walker))
+	assert.NoError(uow.Commit(ctx))
+
+	// Оба заказа в одной сумке
+	got, err := uow.CourierRepository().Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.Len(got.StoragePlaces(), 1)
+	assert.ElementsMatch([]uuid.UUID{first.ID(), second.ID()}, got.OrderIDs())
+	assert.Equal(5, got.Load())
+
+	// Выданный заказ удаляется из дочерней таблицы
+	assert.NoError(got.CompleteOrder(first))
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Update(ctx, got))
+	assert.NoError(uow.Commit(ctx))
+
+	var stored []courierrepo.StoredOrderDTO
+	assert.NoError(db.Find(&stored).Error)
+	assert.Len(stored, 1)
+	assert.Equal(second.ID(), stored[0].OrderID)
+	assert.Equal(3, stored[0].Volume)
+}
+
+func Test_MigrateStoredOrdersShouldMoveLegacyOrders(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	// Строки в старом формате: один заказ на место хранения
+	courierID, placeID, orderID := uuid.New(), uuid.New(), uuid.New()
+	assert.NoError(db.Exec(
+		`INSERT INTO couriers (id, name, speed, location_x, location_y) VALUES (?, 'legacy', 1, 1, 1)`,
+		courierID).Error)
+	assert.NoError(db.Exec(
+		`INSERT INTO storage_places (id, name, order_id, total_volume, courier_id) VALUES (?, 'Сумка', ?, 10, ?)`,
+		placeID, orderID, courierID).Error)
+	assert.NoError(db.Exec(
+		`INSERT INTO orders (id, location_x, location_y, volume, status) VALUES (?, 2, 2, 4, 'Assigned')`,
+		orderID).Error)
+
+	assert.NoError(courierrepo.MigrateStoredOrders(ctx, db))
+	// Повторный запуск ничего не ломает
+	assert.NoError(courierrepo.MigrateStoredOrders(ctx, db))
+
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+
+	got, err := uow.CourierRepository().Get(ctx, courierID)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{orderID}, got.OrderIDs())
+	assert.Equal(4, got.Load())
+}
+
 func Test_OrderRepositoryShouldCanAddOrder(t *testing.T) {
 	assert := assert.New(t)
 	// Инициализируем окружение
@@ -65,7 +187,7 @@ func Test_OrderRepositoryShouldCanAddOrder(t *testing.T) {
 	assert.NoError(err)
 
 	// Создаем UnitOfWork
-	factory, err := NewUnitOfWorkFactory(db)
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
 	assert.NoError(err)
 	uow, err := factory.New(ctx)
 	assert.NoError(err)
@@ -96,6 +218,241 @@ func Test_OrderRepositoryShouldCanAddOrder(t *testing.T) {
 	assert.Equal(order.Status(), dto.Status)
 }
 
+type testDomainEvent struct {
+	ID      uuid.UUID
+	OrderID uuid.UUID
+}
+
+func (e testDomainEvent) GetID() uuid.UUID { return e.ID }
+
+func (e testDomainEvent) GetName() string { return "test.event" }
+
+func Test_OrderRepositoryShouldQueueByPriorityThenAge(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+
+	older, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	newer, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	express, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	deadline := express.CreatedAt().Add(time.Hour)
+	assert.NoError(express.SetDeliveryTerms(order.PriorityExpress, &deadline))
+
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	for _, o := range []*order.Order{newer, express, older} {
+		assert.NoError(uow.OrderRepository().Add(ctx, o))
+	}
+	assert.NoError(uow.Commit(ctx))
+
+	// Срочный заказ первый, несмотря на возраст, затем обычные от старых к новым
+	got, err := uow.OrderRepository().GetAllInCreatedStatus(ctx)
+	assert.NoError(err)
+	assert.Len(got, 3)
+	assert.Equal(express.ID(), got[0].ID())
+	assert.Equal(order.PriorityExpress, got[0].Priority())
+	assert.True(deadline.Equal(*got[0].PromisedBy()))
+	assert.Equal(older.ID(), got[1].ID())
+	assert.Equal(newer.ID(), got[2].ID())
+
+	// Отменённые заказы в очередь не попадают
+	for _, o := range got {
+		assert.NoError(o.Cancel())
+		assert.NoError(uow.OrderRepository().Update(ctx, o))
+	}
+	_, err = uow.OrderRepository().GetAllInCreatedStatus(ctx)
+	assert.ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func Test_UnitOfWorkShouldSaveDomainEventsToOutbox(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+
+	o, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 5)
+	assert.NoError(err)
+	event := testDomainEvent{ID: uuid.New(), OrderID: o.ID()}
+	o.RaiseDomainEvent(event)
+
+	uow.Begin(ctx)
+	assert.NoError(uow.OrderRepository().Add(ctx, o))
+	assert.NoError(uow.OrderRepository().Update(ctx, o))
+	assert.NoError(uow.Commit(ctx))
+
+	// Считываем данные из БД
+	var messages []outbox.MessageDTO
+	err = db.Find(&messages).Error
+	assert.NoError(err)
+
+	// Проверяем эквивалентность
+	assert.Len(messages, 2)
+	names := []string{messages[0].Name, messages[1].Name}
+	assert.ElementsMatch([]string{event.GetName(), order.EventNameOrderCreated}, names)
+	for _, message := range messages {
+		assert.Nil(message.ProcessedAtUtc)
+	}
+	assert.Empty(o.GetDomainEvents())
+}
+
+type testEventHandler struct {
+	events []ddd.DomainEvent
+	err    error
+}
+
+func (h *testEventHandler) Handle(_ context.Context, event ddd.DomainEvent) error {
+	h.events = append(h.events, event)
+	return h.err
+}
+
+func Test_UnitOfWorkShouldPublishDomainEventsAfterCommit(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	handler := &testEventHandler{}
+	mediatr := ddd.NewMediatr()
+	mediatr.Subscribe(handler, order.OrderCreated{})
+
+	factory, err := NewUnitOfWorkFactory(db, mediatr)
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+
+	o, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 5)
+	assert.NoError(err)
+
+	// До коммита события не публикуются
+	uow.Begin(ctx)
+	assert.NoError(uow.OrderRepository().Add(ctx, o))
+	assert.Empty(handler.events)
+
+	assert.NoError(uow.Commit(ctx))
+	assert.Len(handler.events, 1)
+	assert.Equal(order.EventNameOrderCreated, handler.events[0].GetName())
+}
+
+func Test_UnitOfWorkShouldKeepCommitWhenEventHandlerFails(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	handler := &testEventHandler{err: errors.New("handler failed")}
+	mediatr := ddd.NewMediatr()
+	mediatr.Subscribe(handler, order.OrderCreated{})
+
+	factory, err := NewUnitOfWorkFactory(db, mediatr)
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+
+	o, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 5)
+	assert.NoError(err)
+
+	uow.Begin(ctx)
+	assert.NoError(uow.OrderRepository().Add(ctx, o))
+	// Ошибка обработчика не отменяет уже выполненную работу
+	assert.NoError(uow.Commit(ctx))
+	assert.Len(handler.events, 1)
+
+	// Транзакция не откатывается
+	var dto orderrepo.OrderDTO
+	assert.NoError(db.First(&dto, "id = ?", o.ID()).Error)
+}
+
+func Test_InboxRepositoryShouldRejectProcessedMessage(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+
+	message := ports.InboxMessage{ID: uuid.NewString(), Source: "test"}
+	o, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 5)
+	assert.NoError(err)
+
+	// Первая доставка
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.InboxRepository().Register(ctx, message))
+	assert.NoError(uow.OrderRepository().Add(ctx, o))
+	assert.NoError(uow.Commit(ctx))
+
+	// Повторная доставка
+	uow, err = factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	err = uow.InboxRepository().Register(ctx, message)
+	assert.ErrorIs(err, ports.ErrMessageAlreadyProcessed)
+	uow.RollbackUnlessCommitted(ctx)
+
+	var count int64
+	assert.NoError(db.Model(&inbox.MessageDTO{}).Count(&count).Error)
+	assert.Equal(int64(1), count)
+}
+
+func Test_InboxRepositoryShouldKeepSourcesApart(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+
+	// Одинаковый ID из разных источников - разные сообщения
+	id := uuid.NewString()
+	assert.NoError(uow.InboxRepository().Register(ctx, ports.InboxMessage{ID: id, Source: "first"}))
+	assert.NoError(uow.InboxRepository().Register(ctx, ports.InboxMessage{ID: id, Source: "second"}))
+	err = uow.InboxRepository().Register(ctx, ports.InboxMessage{ID: id, Source: "second"})
+	assert.ErrorIs(err, ports.ErrMessageAlreadyProcessed)
+
+	var count int64
+	assert.NoError(db.Model(&inbox.MessageDTO{}).Count(&count).Error)
+	assert.Equal(int64(2), count)
+}
+
+func Test_InboxRepositoryShouldRollbackWithCommand(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	factory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+
+	message := ports.InboxMessage{ID: uuid.NewString(), Source: "test"}
+
+	uow.Begin(ctx)
+	assert.NoError(uow.InboxRepository().Register(ctx, message))
+	uow.RollbackUnlessCommitted(ctx)
+
+	// Сообщение можно обработать снова
+	assert.NoError(uow.InboxRepository().Register(ctx, message))
+}
+
 func setupTest(t *testing.T) (context.Context, *gorm.DB, error) {
 	ctx := context.Background()
 	postgresContainer, dsn, err := testcnts.StartPostgresContainer(ctx)
@@ -110,10 +467,16 @@ func setupTest(t *testing.T) (context.Context, *gorm.DB, error) {
 	// Авто миграция (создаём таблицу)
 	err = db.AutoMigrate(&courierrepo.StoragePlaceDTO{})
 	assert.NoError(t, err)
+	err = db.AutoMigrate(&courierrepo.StoredOrderDTO{})
+	assert.NoError(t, err)
 	err = db.AutoMigrate(&courierrepo.CourierDTO{})
 	assert.NoError(t, err)
 	err = db.AutoMigrate(&orderrepo.OrderDTO{})
 	assert.NoError(t, err)
+	err = db.AutoMigrate(&outbox.MessageDTO{})
+	assert.NoError(t, err)
+	err = db.AutoMigrate(&inbox.MessageDTO{})
+	assert.NoError(t, err)
 
 	// Очистка выполняется после завершения теста
 	t.Cleanup(func() {
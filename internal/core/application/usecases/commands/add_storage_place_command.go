@@ -3,6 +3,7 @@ package commands
 import (
 	"strings"
 
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
@@ -11,11 +12,20 @@ import (
 type AddStoragePlaceCommand struct {
 	courierID   uuid.UUID
 	name        string
+	kind        courier.StoragePlaceKind
 	totalVolume int
+	maxWeight   int
 	valid       bool
 }
 
-func NewAddStoragePlaceCommand(courierID uuid.UUID, name string, totalVolume int) (AddStoragePlaceCommand, error) {
+// NewAddStoragePlaceCommand makes the command, maxWeight is in grams and zero means no limit.
+func NewAddStoragePlaceCommand(
+	courierID uuid.UUID,
+	name string,
+	kind courier.StoragePlaceKind,
+	totalVolume int,
+	maxWeight int,
+) (AddStoragePlaceCommand, error) {
 	if courierID == uuid.Nil {
 		return AddStoragePlaceCommand{}, errs.NewValueIsRequiredError("courierID")
 	}
@@ -25,14 +35,28 @@ func NewAddStoragePlaceCommand(courierID uuid.UUID, name string, totalVolume int
 		return AddStoragePlaceCommand{}, errs.NewValueIsRequiredError("name")
 	}
 
+	if kind.IsEmpty() {
+		return AddStoragePlaceCommand{}, errs.NewValueIsRequiredError("kind")
+	}
+
+	if !kind.IsValid() {
+		return AddStoragePlaceCommand{}, errs.NewValueIsInvalidError("kind")
+	}
+
 	if totalVolume <= 0 {
 		return AddStoragePlaceCommand{}, errs.NewValueIsRequiredError("totalVolume")
 	}
 
+	if maxWeight < 0 {
+		return AddStoragePlaceCommand{}, errs.NewValueIsInvalidError("maxWeight")
+	}
+
 	return AddStoragePlaceCommand{
 		courierID:   courierID,
 		name:        name,
+		kind:        kind,
 		totalVolume: totalVolume,
+		maxWeight:   maxWeight,
 		valid:       true,
 	}, nil
 }
@@ -41,6 +65,10 @@ func (c AddStoragePlaceCommand) CourierID() uuid.UUID { return c.courierID }
 
 func (c AddStoragePlaceCommand) Name() string { return c.name }
 
+func (c AddStoragePlaceCommand) Kind() courier.StoragePlaceKind { return c.kind }
+
 func (c AddStoragePlaceCommand) TotalVolume() int { return c.totalVolume }
 
+func (c AddStoragePlaceCommand) MaxWeight() int { return c.maxWeight }
+
 func (c AddStoragePlaceCommand) IsValid() bool { return c.valid }
@@ -38,7 +38,13 @@ func (h *addStoragePlaceCommandHandler) Handle(ctx context.Context, command AddS
 		return err
 	}
 
-	if err = courier.AddStoragePlace(command.Name(), command.TotalVolume()); err != nil {
+	if err = courier.AddStoragePlace(command.Name(), command.Kind(), command.TotalVolume(), command.MaxWeight()); err != nil {
+		return err
+	}
+
+	uow.Begin(ctx)
+
+	if err = registerInboxMessage(ctx, uow); err != nil {
 		return err
 	}
 
@@ -2,10 +2,15 @@ package commands
 
 import (
 	"context"
+	"errors"
 
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
 )
 
 type AssignOrderCommandCommandHandler interface {
@@ -15,9 +20,14 @@ type AssignOrderCommandCommandHandler interface {
 type assignOrderCommandHandler struct {
 	factory    ports.UnitOfWorkFactory
 	dispatcher services.OrderDispatcher
+	stats      ports.CourierStatsRepository
 }
 
-func NewAssignOrderCommandHandler(factory ports.UnitOfWorkFactory, dispatcher services.OrderDispatcher) (*assignOrderCommandHandler, error) {
+func NewAssignOrderCommandHandler(
+	factory ports.UnitOfWorkFactory,
+	dispatcher services.OrderDispatcher,
+	stats ports.CourierStatsRepository,
+) (*assignOrderCommandHandler, error) {
 	if factory == nil {
 		return nil, errs.NewValueIsRequiredError("factory")
 	}
@@ -26,7 +36,11 @@ func NewAssignOrderCommandHandler(factory ports.UnitOfWorkFactory, dispatcher se
 		return nil, errs.NewValueIsRequiredError("dispatcher")
 	}
 
-	return &assignOrderCommandHandler{factory: factory, dispatcher: dispatcher}, nil
+	if stats == nil {
+		return nil, errs.NewValueIsRequiredError("stats")
+	}
+
+	return &assignOrderCommandHandler{factory: factory, dispatcher: dispatcher, stats: stats}, nil
 }
 
 func (h *assignOrderCommandHandler) Handle(ctx context.Context, command AssignOrderCommand) error {
@@ -40,7 +54,7 @@ func (h *assignOrderCommandHandler) Handle(ctx context.Context, command AssignOr
 	}
 	defer uow.RollbackUnlessCommitted(ctx)
 
-	order, err := uow.OrderRepository().GetFirstInCreatedStatus(ctx)
+	orders, err := uow.OrderRepository().GetAllInCreatedStatus(ctx)
 	if err != nil {
 		return err
 	}
@@ -50,18 +64,46 @@ func (h *assignOrderCommandHandler) Handle(ctx context.Context, command AssignOr
 		return err
 	}
 
-	courier, err := h.dispatcher.Dispatch(order, couriers)
+	stats, err := h.stats.GetAll(ctx, courierIDs(couriers))
 	if err != nil {
 		return err
 	}
 
-	if err = uow.OrderRepository().Update(ctx, order); err != nil {
+	// Заказ, который не может взять ни один курьер (например, нужен холодильник), не должен
+	// задерживать остальных: пробуем заказы по очереди, пока один не будет назначен
+	var assigned *order.Order
+	var assignee *courier.Courier
+	for _, candidate := range orders {
+		assignee, err = h.dispatcher.Dispatch(candidate, couriers, stats)
+		if err == nil {
+			assigned = candidate
+			break
+		}
+		if !errors.Is(err, services.ErrNoRightCourier) {
+			return err
+		}
+	}
+	if assigned == nil {
 		return err
 	}
 
-	if err = uow.CourierRepository().Update(ctx, courier); err != nil {
+	uow.Begin(ctx)
+
+	if err = uow.OrderRepository().Update(ctx, assigned); err != nil {
+		return err
+	}
+
+	if err = uow.CourierRepository().Update(ctx, assignee); err != nil {
 		return err
 	}
 
 	return uow.Commit(ctx)
 }
+
+func courierIDs(couriers []*courier.Courier) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(couriers))
+	for _, c := range couriers {
+		ids = append(ids, c.ID())
+	}
+	return ids
+}
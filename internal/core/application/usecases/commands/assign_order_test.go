@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/statsrepo"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AssignOrderCommandSkipsUndispatchableOrders(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	walker, err := courier.NewCourier("test", courier.TransportTypePedestrian, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+
+	// Срочный заказ первым в очереди, но он тяжелее любой сумки
+	heavy, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	deadline := heavy.CreatedAt().Add(time.Hour)
+	assert.NoError(heavy.SetDeliveryTerms(order.PriorityExpress, &deadline))
+	assert.NoError(heavy.SetWeight(1_000_000))
+	normal, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, walker))
+	assert.NoError(uow.OrderRepository().Add(ctx, heavy))
+	assert.NoError(uow.OrderRepository().Add(ctx, normal))
+	assert.NoError(uow.Commit(ctx))
+
+	stats, err := statsrepo.NewRepository(db)
+	assert.NoError(err)
+	handler, err := NewAssignOrderCommandHandler(factory, services.NewOrderDispatcher(kernel.DefaultArea()), stats)
+	assert.NoError(err)
+	command, err := NewAssignOrderCommand()
+	assert.NoError(err)
+
+	// Обычный заказ назначен, несмотря на застрявший перед ним срочный
+	assert.NoError(handler.Handle(ctx, command))
+
+	got, err := uow.OrderRepository().Get(ctx, normal.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusAssigned, got.Status())
+	assert.Equal(walker.ID(), *got.CourierID())
+
+	got, err = uow.OrderRepository().Get(ctx, heavy.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusCreated, got.Status())
+
+	// Остался только заказ, который никто не может взять
+	assert.ErrorIs(handler.Handle(ctx, command), services.ErrNoRightCourier)
+}
@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type CancelOrderCommand struct {
+	orderID uuid.UUID
+	valid   bool
+}
+
+func NewCancelOrderCommand(orderID uuid.UUID) (CancelOrderCommand, error) {
+	if orderID == uuid.Nil {
+		return CancelOrderCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	return CancelOrderCommand{orderID: orderID, valid: true}, nil
+}
+
+func (c CancelOrderCommand) OrderID() uuid.UUID { return c.orderID }
+
+func (c CancelOrderCommand) IsValid() bool { return c.valid }
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type CancelOrderCommandHandler interface {
+	Handle(context.Context, CancelOrderCommand) error
+}
+
+type cancelOrderCommandHandler struct {
+	factory   ports.UnitOfWorkFactory
+	canceller services.OrderCanceller
+}
+
+func NewCancelOrderCommandHandler(factory ports.UnitOfWorkFactory, canceller services.OrderCanceller) (*cancelOrderCommandHandler, error) {
+	if factory == nil {
+		return nil, errs.NewValueIsRequiredError("factory")
+	}
+
+	if canceller == nil {
+		return nil, errs.NewValueIsRequiredError("canceller")
+	}
+
+	return &cancelOrderCommandHandler{factory: factory, canceller: canceller}, nil
+}
+
+func (h *cancelOrderCommandHandler) Handle(ctx context.Context, command CancelOrderCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	uow, err := h.factory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	order, err := uow.OrderRepository().Get(ctx, command.OrderID())
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return errs.NewObjectNotFoundError("order", command.OrderID())
+	}
+
+	var assignee *courier.Courier
+	if order.CourierID() != nil {
+		assignee, err = uow.CourierRepository().Get(ctx, *order.CourierID())
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = h.canceller.Cancel(order, assignee); err != nil {
+		return err
+	}
+
+	if err = uow.OrderRepository().Update(ctx, order); err != nil {
+		return err
+	}
+
+	if assignee != nil {
+		if err = uow.CourierRepository().Update(ctx, assignee); err != nil {
+			return err
+		}
+	}
+
+	return uow.Commit(ctx)
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"testing"
+
+	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CancelOrderCommand(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	loc1, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	loc2, err := kernel.NewLocation(9, 9)
+	assert.NoError(err)
+
+	courier, err := courier.NewCourier("test", courier.TransportTypePedestrian, 2, loc1)
+	assert.NoError(err)
+	assigned, err := order.NewOrder(uuid.New(), loc2, 1)
+	assert.NoError(err)
+	assert.NoError(courier.TakeOrder(assigned))
+	assert.NoError(assigned.Assign(courier.ID()))
+
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, courier))
+	assert.NoError(uow.OrderRepository().Add(ctx, assigned))
+	assert.NoError(uow.Commit(ctx))
+
+	// Отменяем
+	handler, err := NewCancelOrderCommandHandler(factory, services.NewOrderCanceller())
+	assert.NoError(err)
+	command, err := NewCancelOrderCommand(assigned.ID())
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+
+	got, err := uow.OrderRepository().Get(ctx, assigned.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusCancelled, got.Status())
+
+	courier, err = uow.CourierRepository().Get(ctx, courier.ID())
+	assert.NoError(err)
+	for _, sp := range courier.StoragePlaces() {
+		assert.False(sp.IsOccupied())
+	}
+
+	// Повторная отмена невозможна
+	assert.ErrorIs(handler.Handle(ctx, command), errs.ErrExpectationFailed)
+
+	// Неизвестный заказ
+	command, err = NewCancelOrderCommand(uuid.New())
+	assert.NoError(err)
+	assert.ErrorIs(handler.Handle(ctx, command), errs.ErrObjectNotFound)
+
+	// Курьер не едет к отмененному заказу
+	move, err := NewMoveCouriersCommandHandler(factory, services.NewRoutePlanner(kernel.DefaultArea()), kernel.DefaultArea())
+	assert.NoError(err)
+	moveCommand, err := NewMoveCouriersCommand()
+	assert.NoError(err)
+	assert.NoError(move.Handle(ctx, moveCommand))
+
+	courier, err = uow.CourierRepository().Get(ctx, courier.ID())
+	assert.NoError(err)
+	assert.True(loc1.Equals(courier.Location()))
+}
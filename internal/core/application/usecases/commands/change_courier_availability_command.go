@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type ChangeCourierAvailabilityCommand struct {
+	courierID    uuid.UUID
+	availability courier.Availability
+	valid        bool
+}
+
+func NewChangeCourierAvailabilityCommand(courierID uuid.UUID, availability courier.Availability) (ChangeCourierAvailabilityCommand, error) {
+	if courierID == uuid.Nil {
+		return ChangeCourierAvailabilityCommand{}, errs.NewValueIsRequiredError("courierID")
+	}
+
+	if availability.IsEmpty() {
+		return ChangeCourierAvailabilityCommand{}, errs.NewValueIsRequiredError("availability")
+	}
+
+	if !availability.IsValid() {
+		return ChangeCourierAvailabilityCommand{}, errs.NewValueIsInvalidError("availability")
+	}
+
+	return ChangeCourierAvailabilityCommand{courierID: courierID, availability: availability, valid: true}, nil
+}
+
+func (c ChangeCourierAvailabilityCommand) CourierID() uuid.UUID { return c.courierID }
+
+func (c ChangeCourierAvailabilityCommand) Availability() courier.Availability { return c.availability }
+
+func (c ChangeCourierAvailabilityCommand) IsValid() bool { return c.valid }
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type ChangeCourierAvailabilityCommandHandler interface {
+	Handle(context.Context, ChangeCourierAvailabilityCommand) error
+}
+
+type changeCourierAvailabilityCommandHandler struct {
+	factory ports.UnitOfWorkFactory
+}
+
+func NewChangeCourierAvailabilityCommandHandler(factory ports.UnitOfWorkFactory) (*changeCourierAvailabilityCommandHandler, error) {
+	if factory == nil {
+		return nil, errs.NewValueIsRequiredError("factory")
+	}
+	return &changeCourierAvailabilityCommandHandler{factory: factory}, nil
+}
+
+func (h *changeCourierAvailabilityCommandHandler) Handle(ctx context.Context, command ChangeCourierAvailabilityCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	uow, err := h.factory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	cur, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+
+	switch command.Availability() {
+	case courier.AvailabilityOnline:
+		err = cur.GoOnline()
+	case courier.AvailabilityOffline:
+		err = cur.GoOffline()
+	case courier.AvailabilityOnBreak:
+		err = cur.StartBreak()
+	default:
+		err = errs.NewValueIsInvalidError("availability")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = uow.CourierRepository().Update(ctx, cur); err != nil {
+		return err
+	}
+
+	return uow.Commit(ctx)
+}
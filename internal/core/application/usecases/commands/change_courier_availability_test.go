@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"testing"
+
+	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ChangeCourierAvailabilityCommand(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	cur, err := courier.NewCourier("test", courier.TransportTypePedestrian, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, cur))
+	assert.NoError(uow.Commit(ctx))
+
+	handler, err := NewChangeCourierAvailabilityCommandHandler(factory)
+	assert.NoError(err)
+
+	// Уходим с линии
+	command, err := NewChangeCourierAvailabilityCommand(cur.ID(), courier.AvailabilityOffline)
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+
+	got, err := uow.CourierRepository().Get(ctx, cur.ID())
+	assert.NoError(err)
+	assert.Equal(courier.AvailabilityOffline, got.Availability())
+
+	// Свободных курьеров нет
+	_, err = uow.CourierRepository().GetAllFree(ctx)
+	assert.ErrorIs(err, errs.ErrObjectNotFound)
+
+	// Неизвестный курьер
+	command, err = NewChangeCourierAvailabilityCommand(uuid.New(), courier.AvailabilityOnline)
+	assert.NoError(err)
+	assert.ErrorIs(handler.Handle(ctx, command), errs.ErrObjectNotFound)
+
+	// Неизвестный статус
+	_, err = NewChangeCourierAvailabilityCommand(cur.ID(), courier.Availability("Sleeping"))
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+}
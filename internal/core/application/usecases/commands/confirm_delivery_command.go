@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"strings"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type ConfirmDeliveryCommand struct {
+	orderID uuid.UUID
+	code    string
+	valid   bool
+}
+
+func NewConfirmDeliveryCommand(orderID uuid.UUID, code string) (ConfirmDeliveryCommand, error) {
+	if orderID == uuid.Nil {
+		return ConfirmDeliveryCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return ConfirmDeliveryCommand{}, errs.NewValueIsRequiredError("code")
+	}
+
+	return ConfirmDeliveryCommand{orderID: orderID, code: code, valid: true}, nil
+}
+
+func (c ConfirmDeliveryCommand) OrderID() uuid.UUID { return c.orderID }
+
+func (c ConfirmDeliveryCommand) Code() string { return c.code }
+
+func (c ConfirmDeliveryCommand) IsValid() bool { return c.valid }
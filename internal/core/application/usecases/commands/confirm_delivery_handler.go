@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type ConfirmDeliveryCommandHandler interface {
+	Handle(context.Context, ConfirmDeliveryCommand) error
+}
+
+type confirmDeliveryCommandHandler struct {
+	factory ports.UnitOfWorkFactory
+}
+
+func NewConfirmDeliveryCommandHandler(factory ports.UnitOfWorkFactory) (*confirmDeliveryCommandHandler, error) {
+	if factory == nil {
+		return nil, errs.NewValueIsRequiredError("factory")
+	}
+
+	return &confirmDeliveryCommandHandler{factory: factory}, nil
+}
+
+func (h *confirmDeliveryCommandHandler) Handle(ctx context.Context, command ConfirmDeliveryCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	uow, err := h.factory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	delivered, err := uow.OrderRepository().Get(ctx, command.OrderID())
+	if err != nil {
+		return err
+	}
+	if delivered == nil {
+		return errs.NewObjectNotFoundError("order", command.OrderID())
+	}
+
+	// Неверный код тоже сохраняем: попытки ограничены, после последней доставка не удалась
+	confirmErr := delivered.Confirm(command.Code())
+	if confirmErr != nil &&
+		!errors.Is(confirmErr, order.ErrConfirmationCodeMismatch) &&
+		!errors.Is(confirmErr, order.ErrConfirmationAttemptsExceeded) {
+		return confirmErr
+	}
+
+	if !errors.Is(confirmErr, order.ErrConfirmationCodeMismatch) && delivered.CourierID() != nil {
+		assignee, err := uow.CourierRepository().Get(ctx, *delivered.CourierID())
+		if err != nil {
+			return err
+		}
+		if confirmErr == nil {
+			err = assignee.CompleteOrder(delivered)
+		} else {
+			err = assignee.ReleaseOrder(delivered)
+		}
+		if err != nil {
+			return err
+		}
+		if err = uow.CourierRepository().Update(ctx, assignee); err != nil {
+			return err
+		}
+	}
+
+	if err = uow.OrderRepository().Update(ctx, delivered); err != nil {
+		return err
+	}
+
+	if err = uow.Commit(ctx); err != nil {
+		return err
+	}
+	return confirmErr
+}
@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/outbox"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ConfirmDeliveryCommand(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	factory, err := postgres.NewUnitOfWorkFactoryWithCipher(db, ddd.NewMediatr(), newTestCipher(t))
+	assert.NoError(err)
+	walker, awaiting, code := saveAwaitingOrder(t, ctx, factory, time.Now().UTC())
+
+	handler, err := NewConfirmDeliveryCommandHandler(factory)
+	assert.NoError(err)
+
+	// Неверный код сохраняется как попытка, заказ остается у курьера
+	command, err := NewConfirmDeliveryCommand(awaiting.ID(), wrongCode(code))
+	assert.NoError(err)
+	assert.ErrorIs(handler.Handle(ctx, command), order.ErrConfirmationCodeMismatch)
+
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	got, err := uow.OrderRepository().Get(ctx, awaiting.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusAwaitingConfirmation, got.Status())
+	assert.Equal(1, got.Confirmation().Attempts())
+
+	// Верный код завершает заказ и освобождает место хранения
+	command, err = NewConfirmDeliveryCommand(awaiting.ID(), code)
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+
+	got, err = uow.OrderRepository().Get(ctx, awaiting.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusCompleted, got.Status())
+
+	walker, err = uow.CourierRepository().Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.Empty(walker.OrderIDs())
+	for _, sp := range walker.StoragePlaces() {
+		assert.False(sp.IsOccupied())
+	}
+}
+
+func Test_ConfirmDeliveryCommandAttemptsExceeded(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	factory, err := postgres.NewUnitOfWorkFactoryWithCipher(db, ddd.NewMediatr(), newTestCipher(t))
+	assert.NoError(err)
+	walker, awaiting, code := saveAwaitingOrder(t, ctx, factory, time.Now().UTC())
+
+	handler, err := NewConfirmDeliveryCommandHandler(factory)
+	assert.NoError(err)
+	command, err := NewConfirmDeliveryCommand(awaiting.ID(), wrongCode(code))
+	assert.NoError(err)
+	for range order.MaxConfirmationAttempts - 1 {
+		assert.ErrorIs(handler.Handle(ctx, command), order.ErrConfirmationCodeMismatch)
+	}
+
+	// Последняя неверная попытка означает неудачную доставку, курьер освобождается
+	assert.ErrorIs(handler.Handle(ctx, command), order.ErrConfirmationAttemptsExceeded)
+
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	got, err := uow.OrderRepository().Get(ctx, awaiting.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusFailed, got.Status())
+	assert.Equal(order.FailureReasonNotConfirmed, got.Failure().Reason())
+
+	walker, err = uow.CourierRepository().Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.Empty(walker.OrderIDs())
+}
+
+// saveAwaitingOrder saves a courier waiting at the door of the customer since arrivedAt
+// and returns the confirmation code of the order.
+func saveAwaitingOrder(t *testing.T, ctx context.Context, factory ports.UnitOfWorkFactory, arrivedAt time.Time) (*courier.Courier, *order.Order, string) {
+	assert := assert.New(t)
+
+	location, err := kernel.NewLocation(5, 5)
+	assert.NoError(err)
+	walker, err := courier.NewCourier("walker", courier.TransportTypePedestrian, 1, location)
+	assert.NoError(err)
+	awaiting, err := order.NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	code, err := awaiting.RequireConfirmation()
+	assert.NoError(err)
+	assert.NoError(walker.TakeOrder(awaiting))
+	assert.NoError(awaiting.Assign(walker.ID()))
+	assert.NoError(awaiting.AwaitConfirmation(arrivedAt))
+
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, walker))
+	assert.NoError(uow.OrderRepository().Add(ctx, awaiting))
+	assert.NoError(uow.Commit(ctx))
+
+	return walker, awaiting, code
+}
+
+// newTestCipher encrypts confirmation codes in the outbox.
+func newTestCipher(t *testing.T) *outbox.Cipher {
+	cipher, err := outbox.NewCipher("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+	assert.NoError(t, err)
+	return cipher
+}
+
+func wrongCode(code string) string {
+	if code == "0000" {
+		return "0001"
+	}
+	return "0000"
+}
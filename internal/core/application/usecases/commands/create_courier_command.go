@@ -3,16 +3,20 @@ package commands
 import (
 	"strings"
 
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/pkg/errs"
 )
 
 type CreateCourierCommand struct {
-	name  string
-	speed int
-	valid bool
+	name      string
+	transport courier.TransportType
+	speed     int
+	valid     bool
 }
 
-func NewCreateCourierCommand(name string, speed int) (CreateCourierCommand, error) {
+// NewCreateCourierCommand validates the speed against the transport. The transport is optional,
+// without it the courier is created by speed, see courier.NewCourierForSpeed.
+func NewCreateCourierCommand(name string, transport courier.TransportType, speed int) (CreateCourierCommand, error) {
 	name = strings.TrimSpace(name)
 	if name == "" {
 		return CreateCourierCommand{}, errs.NewValueIsRequiredError("name")
@@ -22,15 +26,24 @@ func NewCreateCourierCommand(name string, speed int) (CreateCourierCommand, erro
 		return CreateCourierCommand{}, errs.NewValueIsRequiredError("speed")
 	}
 
+	if !transport.IsEmpty() {
+		if err := transport.ValidateSpeed(speed); err != nil {
+			return CreateCourierCommand{}, err
+		}
+	}
+
 	return CreateCourierCommand{
-		name:  name,
-		speed: speed,
-		valid: true,
+		name:      name,
+		transport: transport,
+		speed:     speed,
+		valid:     true,
 	}, nil
 }
 
 func (c CreateCourierCommand) Name() string { return c.name }
 
+func (c CreateCourierCommand) Transport() courier.TransportType { return c.transport }
+
 func (c CreateCourierCommand) Speed() int { return c.speed }
 
 func (c CreateCourierCommand) IsValid() bool { return c.valid }
@@ -15,13 +15,17 @@ type CreateCourierCommandHandler interface {
 
 type createCourierCommandHandler struct {
 	factory ports.UnitOfWorkFactory
+	area    kernel.Area
 }
 
-func NewCreateCourierCommandHandler(factory ports.UnitOfWorkFactory) (*createCourierCommandHandler, error) {
+func NewCreateCourierCommandHandler(factory ports.UnitOfWorkFactory, area kernel.Area) (*createCourierCommandHandler, error) {
 	if factory == nil {
 		return nil, errs.NewValueIsRequiredError("factory")
 	}
-	return &createCourierCommandHandler{factory: factory}, nil
+	if !area.IsValid() {
+		return nil, errs.NewValueIsRequiredError("area")
+	}
+	return &createCourierCommandHandler{factory: factory, area: area}, nil
 }
 
 func (h *createCourierCommandHandler) Handle(ctx context.Context, command CreateCourierCommand) error {
@@ -35,14 +39,25 @@ func (h *createCourierCommandHandler) Handle(ctx context.Context, command Create
 	}
 	defer uow.RollbackUnlessCommitted(ctx)
 
-	courier, err := courier.NewCourier(command.Name(), command.Speed(), kernel.NewRandomLocation())
+	var cur *courier.Courier
+	if command.Transport().IsEmpty() {
+		cur, err = courier.NewCourierForSpeed(command.Name(), command.Speed(), h.area.NewRandomLocation())
+	} else {
+		cur, err = courier.NewCourier(command.Name(), command.Transport(), command.Speed(), h.area.NewRandomLocation())
+	}
 	if err != nil {
 		return err
 	}
 
 	// Сохранили
 
-	if err = uow.CourierRepository().Add(ctx, courier); err != nil {
+	uow.Begin(ctx)
+
+	if err = registerInboxMessage(ctx, uow); err != nil {
+		return err
+	}
+
+	if err = uow.CourierRepository().Add(ctx, cur); err != nil {
 		return err
 	}
 
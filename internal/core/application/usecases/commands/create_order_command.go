@@ -2,20 +2,36 @@ package commands
 
 import (
 	"strings"
+	"time"
 
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
 )
 
 type CreateOrderCommand struct {
-	orderID uuid.UUID
-	street  string
-	volume  int
-	valid   bool
+	orderID    uuid.UUID
+	street     string
+	pickup     string
+	volume     int
+	weight     int
+	handling   order.Handling
+	priority   order.Priority
+	promisedBy *time.Time
+	valid      bool
 }
 
-func NewCreateOrderCommand(orderID uuid.UUID, street string, volume int) (CreateOrderCommand, error) {
+func NewCreateOrderCommand(
+	orderID uuid.UUID,
+	street string,
+	pickupStreet string,
+	volume int,
+	weight int,
+	handling order.Handling,
+	priority order.Priority,
+	promisedBy *time.Time,
+) (CreateOrderCommand, error) {
 	if orderID == uuid.Nil {
 		return CreateOrderCommand{}, errs.NewValueIsRequiredError("orderID")
 	}
@@ -29,11 +45,40 @@ func NewCreateOrderCommand(orderID uuid.UUID, street string, volume int) (Create
 		return CreateOrderCommand{}, errs.NewValueIsRequiredError("volume")
 	}
 
+	if weight < 0 {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("weight")
+	}
+
+	if handling.IsEmpty() {
+		return CreateOrderCommand{}, errs.NewValueIsRequiredError("handling")
+	}
+
+	if !handling.IsValid() {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("handling")
+	}
+
+	if priority.IsEmpty() {
+		return CreateOrderCommand{}, errs.NewValueIsRequiredError("priority")
+	}
+
+	if !priority.IsValid() {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("priority")
+	}
+
+	if promisedBy != nil && promisedBy.IsZero() {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("promisedBy")
+	}
+
 	return CreateOrderCommand{
-		orderID: orderID,
-		street:  street,
-		volume:  volume,
-		valid:   true,
+		orderID:    orderID,
+		street:     street,
+		pickup:     strings.TrimSpace(pickupStreet),
+		volume:     volume,
+		weight:     weight,
+		handling:   handling,
+		priority:   priority,
+		promisedBy: promisedBy,
+		valid:      true,
 	}, nil
 }
 
@@ -41,6 +86,18 @@ func (c CreateOrderCommand) OrderID() uuid.UUID { return c.orderID }
 
 func (c CreateOrderCommand) Street() string { return c.street }
 
+// PickupStreet is where the order is collected, empty for the default warehouse.
+func (c CreateOrderCommand) PickupStreet() string { return c.pickup }
+
 func (c CreateOrderCommand) Volume() int { return c.volume }
 
+// Weight is in grams, zero when the weight is not known.
+func (c CreateOrderCommand) Weight() int { return c.weight }
+
+func (c CreateOrderCommand) Handling() order.Handling { return c.handling }
+
+func (c CreateOrderCommand) Priority() order.Priority { return c.priority }
+
+func (c CreateOrderCommand) PromisedBy() *time.Time { return c.promisedBy }
+
 func (c CreateOrderCommand) IsValid() bool { return c.valid }
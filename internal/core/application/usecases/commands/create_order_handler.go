@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"strings"
 
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
@@ -13,18 +14,34 @@ type CreateOrderCommandHandler interface {
 }
 
 type createOrderCommandHandler struct {
-	factory   ports.UnitOfWorkFactory
-	geoClient ports.GeoClient
+	factory             ports.UnitOfWorkFactory
+	geoClient           ports.GeoClient
+	warehouseStreet     string
+	requireConfirmation bool
 }
 
-func NewCreateOrderCommandHandler(factory ports.UnitOfWorkFactory, geoClient ports.GeoClient) (*createOrderCommandHandler, error) {
+// NewCreateOrderCommandHandler makes the handler, orders without a pickup street
+// are collected at warehouseStreet. Without a warehouse such orders have no pickup leg
+// and the courier starts with the order on board. With requireConfirmation every order gets a code
+// the customer tells the courier on delivery.
+func NewCreateOrderCommandHandler(
+	factory ports.UnitOfWorkFactory,
+	geoClient ports.GeoClient,
+	warehouseStreet string,
+	requireConfirmation bool,
+) (*createOrderCommandHandler, error) {
 	if factory == nil {
 		return nil, errs.NewValueIsRequiredError("factory")
 	}
 	if geoClient == nil {
 		return nil, errs.NewValueIsRequiredError("geoClient")
 	}
-	return &createOrderCommandHandler{factory: factory, geoClient: geoClient}, nil
+	return &createOrderCommandHandler{
+		factory:             factory,
+		geoClient:           geoClient,
+		warehouseStreet:     strings.TrimSpace(warehouseStreet),
+		requireConfirmation: requireConfirmation,
+	}, nil
 }
 
 func (h *createOrderCommandHandler) Handle(ctx context.Context, command CreateOrderCommand) error {
@@ -48,6 +65,44 @@ func (h *createOrderCommandHandler) Handle(ctx context.Context, command CreateOr
 		return err
 	}
 
+	pickupStreet := command.PickupStreet()
+	if pickupStreet == "" {
+		pickupStreet = h.warehouseStreet
+	}
+	if pickupStreet != "" {
+		pickup, err := h.geoClient.GetGeolocation(ctx, pickupStreet)
+		if err != nil {
+			return err
+		}
+		if err = order.SetPickup(pickup); err != nil {
+			return err
+		}
+	}
+
+	if err = order.SetWeight(command.Weight()); err != nil {
+		return err
+	}
+
+	if err = order.SetHandling(command.Handling()); err != nil {
+		return err
+	}
+
+	if err = order.SetDeliveryTerms(command.Priority(), command.PromisedBy()); err != nil {
+		return err
+	}
+
+	if h.requireConfirmation {
+		if _, err = order.RequireConfirmation(); err != nil {
+			return err
+		}
+	}
+
+	uow.Begin(ctx)
+
+	if err = registerInboxMessage(ctx, uow); err != nil {
+		return err
+	}
+
 	if err = uow.OrderRepository().Add(ctx, order); err != nil {
 		return err
 	}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type FailDeliveryCommand struct {
+	orderID uuid.UUID
+	reason  order.FailureReason
+	valid   bool
+}
+
+func NewFailDeliveryCommand(orderID uuid.UUID, reason order.FailureReason) (FailDeliveryCommand, error) {
+	if orderID == uuid.Nil {
+		return FailDeliveryCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if reason.IsEmpty() {
+		return FailDeliveryCommand{}, errs.NewValueIsRequiredError("reason")
+	}
+
+	if !reason.IsValid() {
+		return FailDeliveryCommand{}, errs.NewValueIsInvalidError("reason")
+	}
+
+	return FailDeliveryCommand{orderID: orderID, reason: reason, valid: true}, nil
+}
+
+func (c FailDeliveryCommand) OrderID() uuid.UUID { return c.orderID }
+
+func (c FailDeliveryCommand) Reason() order.FailureReason { return c.reason }
+
+func (c FailDeliveryCommand) IsValid() bool { return c.valid }
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type FailDeliveryCommandHandler interface {
+	Handle(context.Context, FailDeliveryCommand) error
+}
+
+type failDeliveryCommandHandler struct {
+	factory ports.UnitOfWorkFactory
+}
+
+// NewFailDeliveryCommandHandler makes the handler which records that the courier could not
+// hand the order over. The courier is freed, the order waits for RetryFailedDeliveries.
+func NewFailDeliveryCommandHandler(factory ports.UnitOfWorkFactory) (*failDeliveryCommandHandler, error) {
+	if factory == nil {
+		return nil, errs.NewValueIsRequiredError("factory")
+	}
+
+	return &failDeliveryCommandHandler{factory: factory}, nil
+}
+
+func (h *failDeliveryCommandHandler) Handle(ctx context.Context, command FailDeliveryCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	uow, err := h.factory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	failed, err := uow.OrderRepository().Get(ctx, command.OrderID())
+	if err != nil {
+		return err
+	}
+	if failed == nil {
+		return errs.NewObjectNotFoundError("order", command.OrderID())
+	}
+	if failed.CourierID() == nil {
+		return errs.NewValueIsRequiredError("courierID")
+	}
+
+	assignee, err := uow.CourierRepository().Get(ctx, *failed.CourierID())
+	if err != nil {
+		return err
+	}
+
+	if err = failed.Fail(command.Reason(), assignee.Location()); err != nil {
+		return err
+	}
+	if err = assignee.ReleaseOrder(failed); err != nil {
+		return err
+	}
+
+	if err = uow.OrderRepository().Update(ctx, failed); err != nil {
+		return err
+	}
+	if err = uow.CourierRepository().Update(ctx, assignee); err != nil {
+		return err
+	}
+
+	return uow.Commit(ctx)
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"testing"
+
+	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FailDeliveryCommand(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	// Курьер уже стоит у клиента
+	location, err := kernel.NewLocation(9, 9)
+	assert.NoError(err)
+
+	walker, err := courier.NewCourier("test", courier.TransportTypePedestrian, 2, location)
+	assert.NoError(err)
+	assigned, err := order.NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	assert.NoError(walker.TakeOrder(assigned))
+	assert.NoError(assigned.Assign(walker.ID()))
+
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, walker))
+	assert.NoError(uow.OrderRepository().Add(ctx, assigned))
+	assert.NoError(uow.Commit(ctx))
+
+	// Курьер не застал клиента
+	handler, err := NewFailDeliveryCommandHandler(factory)
+	assert.NoError(err)
+	command, err := NewFailDeliveryCommand(assigned.ID(), order.FailureReasonCustomerNotHome)
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+
+	got, err := uow.OrderRepository().Get(ctx, assigned.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusFailed, got.Status())
+	assert.Equal(order.FailureReasonCustomerNotHome, got.Failure().Reason())
+	assert.Equal(1, got.Failure().Attempts())
+
+	walker, err = uow.CourierRepository().Get(ctx, walker.ID())
+	assert.NoError(err)
+	for _, sp := range walker.StoragePlaces() {
+		assert.False(sp.IsOccupied())
+	}
+
+	// Повторно отметить неудачу нельзя
+	assert.ErrorIs(handler.Handle(ctx, command), errs.ErrExpectationFailed)
+
+	// Единственная повторная доставка возвращает заказ в диспетчеризацию
+	retry, err := NewRetryFailedDeliveriesCommandHandler(factory, 1)
+	assert.NoError(err)
+	retryCommand, err := NewRetryFailedDeliveriesCommand()
+	assert.NoError(err)
+	assert.NoError(retry.Handle(ctx, retryCommand))
+
+	got, err = uow.OrderRepository().Get(ctx, assigned.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusCreated, got.Status())
+	assert.Nil(got.CourierID())
+
+	// После второй неудачи заказ возвращается отправителю
+	walker, err = uow.CourierRepository().Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.NoError(walker.TakeOrder(got))
+	assert.NoError(got.Assign(walker.ID()))
+	reassign, err := factory.New(ctx)
+	assert.NoError(err)
+	reassign.Begin(ctx)
+	assert.NoError(reassign.CourierRepository().Update(ctx, walker))
+	assert.NoError(reassign.OrderRepository().Update(ctx, got))
+	assert.NoError(reassign.Commit(ctx))
+
+	command, err = NewFailDeliveryCommand(assigned.ID(), order.FailureReasonRefused)
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+	assert.NoError(retry.Handle(ctx, retryCommand))
+
+	got, err = uow.OrderRepository().Get(ctx, assigned.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusReturnedToSender, got.Status())
+	assert.Equal(2, got.Failure().Attempts())
+
+	// Неизвестный заказ
+	command, err = NewFailDeliveryCommand(uuid.New(), order.FailureReasonOther)
+	assert.NoError(err)
+	assert.ErrorIs(handler.Handle(ctx, command), errs.ErrObjectNotFound)
+}
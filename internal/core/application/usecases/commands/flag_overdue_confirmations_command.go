@@ -0,0 +1,9 @@
+package commands
+
+type FlagOverdueConfirmationsCommand struct{ valid bool }
+
+func NewFlagOverdueConfirmationsCommand() (FlagOverdueConfirmationsCommand, error) {
+	return FlagOverdueConfirmationsCommand{valid: true}, nil
+}
+
+func (c FlagOverdueConfirmationsCommand) IsValid() bool { return c.valid }
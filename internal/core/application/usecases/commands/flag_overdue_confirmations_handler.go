@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type FlagOverdueConfirmationsCommandHandler interface {
+	Handle(context.Context, FlagOverdueConfirmationsCommand) error
+}
+
+type flagOverdueConfirmationsCommandHandler struct {
+	factory ports.UnitOfWorkFactory
+	timeout time.Duration
+	now     func() time.Time
+}
+
+// NewFlagOverdueConfirmationsCommandHandler makes the handler which flags and fails deliveries
+// not confirmed by the customer within timeout after the courier arrived. The courier is freed,
+// the order waits for RetryFailedDeliveries.
+func NewFlagOverdueConfirmationsCommandHandler(
+	factory ports.UnitOfWorkFactory,
+	timeout time.Duration,
+	now func() time.Time,
+) (*flagOverdueConfirmationsCommandHandler, error) {
+	if factory == nil {
+		return nil, errs.NewValueIsRequiredError("factory")
+	}
+	if timeout <= 0 {
+		return nil, errs.NewValueIsInvalidError("timeout")
+	}
+	if now == nil {
+		return nil, errs.NewValueIsRequiredError("now")
+	}
+
+	return &flagOverdueConfirmationsCommandHandler{factory: factory, timeout: timeout, now: now}, nil
+}
+
+func (h *flagOverdueConfirmationsCommandHandler) Handle(ctx context.Context, command FlagOverdueConfirmationsCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	uow, err := h.factory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	now := h.now()
+	overdue, err := uow.OrderRepository().GetAllAwaitingConfirmation(ctx, now.Add(-h.timeout))
+	if err != nil {
+		return err
+	}
+	if len(overdue) == 0 {
+		return nil
+	}
+
+	for _, waiting := range overdue {
+		if err = waiting.FlagConfirmationOverdue(now, h.timeout); err != nil {
+			return err
+		}
+		if waiting.CourierID() != nil {
+			assignee, err := uow.CourierRepository().Get(ctx, *waiting.CourierID())
+			if err != nil {
+				return err
+			}
+			if err = assignee.ReleaseOrder(waiting); err != nil {
+				return err
+			}
+			if err = uow.CourierRepository().Update(ctx, assignee); err != nil {
+				return err
+			}
+		}
+		if err = uow.OrderRepository().Update(ctx, waiting); err != nil {
+			return err
+		}
+	}
+
+	return uow.Commit(ctx)
+}
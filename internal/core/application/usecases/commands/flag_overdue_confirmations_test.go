@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FlagOverdueConfirmationsCommand(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	now := time.Now().UTC()
+	factory, err := postgres.NewUnitOfWorkFactoryWithCipher(db, ddd.NewMediatr(), newTestCipher(t))
+	assert.NoError(err)
+	late, overdue, _ := saveAwaitingOrder(t, ctx, factory, now.Add(-10*time.Minute))
+	onTime, waiting, _ := saveAwaitingOrder(t, ctx, factory, now.Add(-time.Minute))
+
+	handler, err := NewFlagOverdueConfirmationsCommandHandler(factory, 5*time.Minute, func() time.Time { return now })
+	assert.NoError(err)
+	command, err := NewFlagOverdueConfirmationsCommand()
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+
+	// Курьер ждал дольше таймаута: доставка не удалась, курьер свободен
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	got, err := uow.OrderRepository().Get(ctx, overdue.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusFailed, got.Status())
+	assert.Equal(order.FailureReasonNotConfirmed, got.Failure().Reason())
+	assert.True(got.Confirmation().IsOverdue())
+
+	late, err = uow.CourierRepository().Get(ctx, late.ID())
+	assert.NoError(err)
+	assert.Empty(late.OrderIDs())
+
+	// Время ожидания второго курьера еще не вышло
+	got, err = uow.OrderRepository().Get(ctx, waiting.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusAwaitingConfirmation, got.Status())
+	onTime, err = uow.CourierRepository().Get(ctx, onTime.ID())
+	assert.NoError(err)
+	assert.Len(onTime.OrderIDs(), 1)
+
+	// Повторный запуск ничего не меняет
+	assert.NoError(handler.Handle(ctx, command))
+}
@@ -0,0 +1,17 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/ports"
+)
+
+// registerInboxMessage deduplicates the inbound message carried by ctx, if any,
+// in the current transaction of the unit of work.
+func registerInboxMessage(ctx context.Context, uow ports.UnitOfWork) error {
+	message, ok := ports.InboxMessageFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return uow.InboxRepository().Register(ctx, message)
+}
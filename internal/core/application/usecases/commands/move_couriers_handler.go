@@ -3,9 +3,16 @@ package commands
 import (
 	"context"
 	"errors"
+	"time"
 
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
 )
 
 type MoveCouriersCommandHandler interface {
@@ -14,13 +21,24 @@ type MoveCouriersCommandHandler interface {
 
 type moveCouriersCommandHandler struct {
 	factory ports.UnitOfWorkFactory
+	planner services.RoutePlanner
+	area    kernel.Area
 }
 
-func NewMoveCouriersCommandHandler(factory ports.UnitOfWorkFactory) (*moveCouriersCommandHandler, error) {
+func NewMoveCouriersCommandHandler(factory ports.UnitOfWorkFactory, planner services.RoutePlanner, area kernel.Area) (*moveCouriersCommandHandler, error) {
 	if factory == nil {
 		return nil, errs.NewValueIsRequiredError("factory")
 	}
-	return &moveCouriersCommandHandler{factory: factory}, nil
+
+	if planner == nil {
+		return nil, errs.NewValueIsRequiredError("planner")
+	}
+
+	if !area.IsValid() {
+		return nil, errs.NewValueIsRequiredError("area")
+	}
+
+	return &moveCouriersCommandHandler{factory: factory, planner: planner, area: area}, nil
 }
 
 func (h *moveCouriersCommandHandler) Handle(ctx context.Context, command MoveCouriersCommand) error {
@@ -34,48 +52,166 @@ func (h *moveCouriersCommandHandler) Handle(ctx context.Context, command MoveCou
 	}
 	defer uow.RollbackUnlessCommitted(ctx)
 
-	orders, err := uow.OrderRepository().GetAllInAssignedStatus(ctx)
+	orders, err := uow.OrderRepository().GetAllWithCouriers(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, order := range orders {
-		courier, err := uow.CourierRepository().Get(ctx, *order.CourierID())
-		if err != nil {
-			if errors.Is(err, errs.ErrObjectNotFound) {
-				return nil
-			}
+	// Курьер может везти несколько заказов, двигаем каждого курьера один раз за шаг
+	courierIDs := make([]uuid.UUID, 0, len(orders))
+	seen := make(map[uuid.UUID]struct{}, len(orders))
+	for _, assigned := range orders {
+		if assigned.CourierID() == nil {
+			continue
+		}
+		if _, ok := seen[*assigned.CourierID()]; ok {
+			continue
+		}
+		seen[*assigned.CourierID()] = struct{}{}
+		courierIDs = append(courierIDs, *assigned.CourierID())
+	}
+
+	for _, courierID := range courierIDs {
+		if err = h.moveCourier(ctx, uow, courierID); err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+func (h *moveCouriersCommandHandler) moveCourier(ctx context.Context, uow ports.UnitOfWork, courierID uuid.UUID) error {
+	uow.Begin(ctx)
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	cur, err := uow.CourierRepository().Get(ctx, courierID)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return nil
+		}
+		return err
+	}
 
-		if err = courier.Move(order.Location()); err != nil {
+	// Заказы могли быть отменены после выборки, перечитываем их в транзакции
+	carried := make([]*order.Order, 0, len(cur.OrderIDs()))
+	for _, orderID := range cur.OrderIDs() {
+		held, err := uow.OrderRepository().Get(ctx, orderID)
+		if err != nil {
 			return err
 		}
+		if held == nil || !held.Status().IsWithCourier() {
+			continue
+		}
+		carried = append(carried, held)
+	}
+	if len(carried) == 0 {
+		return nil
+	}
 
-		if courier.Location().Equals(order.Location()) {
-			if err = order.Complete(); err != nil {
-				return err
+	// Курьер ждет у двери, пока клиент не назовет код подтверждения. Ожидание ограничено:
+	// просроченный или исчерпавший попытки заказ не удается, и курьер едет дальше
+	for _, held := range carried {
+		if held.Status().Equals(order.StatusAwaitingConfirmation) && cur.Location().Equals(held.Location()) {
+			return nil
+		}
+	}
+
+	// Набор заказов или этапов изменился, перестраиваем маршрут
+	if !h.isRouteActual(cur, carried) {
+		stops := make([]courier.RouteStop, 0, 2*len(carried))
+		for _, held := range carried {
+			if needsPickup(held) {
+				stop, err := courier.NewPickupStop(held.ID(), held.Pickup())
+				if err != nil {
+					return err
+				}
+				stops = append(stops, stop)
 			}
 
-			if err = courier.CompleteOrder(order); err != nil {
+			stop, err := courier.NewRouteStop(held.ID(), held.Location())
+			if err != nil {
 				return err
 			}
+			stops = append(stops, stop)
 		}
 
-		uow.Begin(ctx)
-
-		if err = uow.OrderRepository().Update(ctx, order); err != nil {
+		route, err := h.planner.Plan(cur.Location(), stops)
+		if err != nil {
 			return err
 		}
 
-		if err = uow.CourierRepository().Update(ctx, courier); err != nil {
+		if err = cur.SetRoute(route); err != nil {
 			return err
 		}
+	}
+
+	if err = cur.MoveAlongRoute(h.area); err != nil {
+		return err
+	}
+
+	for _, held := range carried {
+		if held.Status().Equals(order.StatusAwaitingConfirmation) {
+			continue
+		}
+		changed := false
+
+		// Забираем заказы в точке выдачи
+		if needsPickup(held) && cur.Location().Equals(held.Pickup()) {
+			if err = held.PickUp(); err != nil {
+				return err
+			}
+			if err = cur.PickUpOrder(held); err != nil {
+				return err
+			}
+			changed = true
+		}
 
-		if err = uow.Commit(ctx); err != nil {
+		// Отдаем заказы по текущему адресу, заказы с кодом ждут подтверждения клиента
+		if !needsPickup(held) && cur.Location().Equals(held.Location()) {
+			if held.RequiresConfirmation() {
+				if err = held.AwaitConfirmation(time.Now().UTC()); err != nil {
+					return err
+				}
+			} else {
+				if err = held.Complete(); err != nil {
+					return err
+				}
+				if err = cur.CompleteOrder(held); err != nil {
+					return err
+				}
+			}
+			changed = true
+		}
+
+		if !changed {
+			continue
+		}
+		if err = uow.OrderRepository().Update(ctx, held); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	if err = uow.CourierRepository().Update(ctx, cur); err != nil {
+		return err
+	}
+
+	return uow.Commit(ctx)
+}
+
+// isRouteActual reports whether the route covers the carried orders and visits
+// the pickup exactly of those orders which are not collected yet.
+func (h *moveCouriersCommandHandler) isRouteActual(cur *courier.Courier, carried []*order.Order) bool {
+	if !cur.IsRouteActual() {
+		return false
+	}
+	for _, held := range carried {
+		if needsPickup(held) != cur.HasPickupStop(held.ID()) {
+			return false
+		}
+	}
+	return true
+}
+
+func needsPickup(held *order.Order) bool {
+	return held.HasPickup() && held.Status().Equals(order.StatusAssigned)
 }
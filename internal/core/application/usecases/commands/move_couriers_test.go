@@ -3,13 +3,19 @@ package commands
 import (
 	"context"
 	"testing"
+	"time"
 
 	"delivery/internal/adapters/out/postgres"
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/inbox"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outbox"
+	"delivery/internal/adapters/out/postgres/statsrepo"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/testcnts"
 
 	"github.com/google/uuid"
@@ -32,10 +38,10 @@ func Test_MoveCouriersCommand(t *testing.T) {
 	loc2, err := kernel.NewLocation(9, 9)
 	assert.NoError(err)
 
-	courier, err := courier.NewCourier(name, speed, loc1)
+	courier, err := courier.NewCourier(name, courier.TransportTypePedestrian, speed, loc1)
 	assert.NoError(err)
 
-	factory, err := postgres.NewUnitOfWorkFactory(db)
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
 	assert.NoError(err)
 
 	order, err := order.NewOrder(uuid.New(), loc2, 1)
@@ -51,7 +57,7 @@ func Test_MoveCouriersCommand(t *testing.T) {
 	// change
 	command, err := NewMoveCouriersCommand()
 	assert.NoError(err)
-	handler, err := NewMoveCouriersCommandHandler(factory)
+	handler, err := NewMoveCouriersCommandHandler(factory, services.NewRoutePlanner(kernel.DefaultArea()), kernel.DefaultArea())
 	assert.NoError(err)
 	err = handler.Handle(ctx, command)
 	assert.NoError(err)
@@ -62,6 +68,205 @@ func Test_MoveCouriersCommand(t *testing.T) {
 	assert.False(loc1.Equals(courier.Location()))
 }
 
+func Test_MoveCouriersCommandWithSeveralOrders(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	start, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	near, err := kernel.NewLocation(2, 2)
+	assert.NoError(err)
+	far, err := kernel.NewLocation(9, 9)
+	assert.NoError(err)
+
+	// Курьер с сумкой и багажником везет два заказа
+	driver, err := courier.NewCourier("driver", courier.TransportTypePedestrian, 2, start)
+	assert.NoError(err)
+	assert.NoError(driver.AddStoragePlace("Багажник", courier.StoragePlaceKindAmbient, 20, 0))
+
+	first, err := order.NewOrder(uuid.New(), far, 1)
+	assert.NoError(err)
+	assert.NoError(driver.TakeOrder(first))
+	assert.NoError(first.Assign(driver.ID()))
+
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, driver))
+	assert.NoError(uow.OrderRepository().Add(ctx, first))
+	assert.NoError(uow.Commit(ctx))
+
+	// С одним заказом курьер остается доступен для диспетчеризации
+	free, err := uow.CourierRepository().GetAllFree(ctx)
+	assert.NoError(err)
+	assert.Len(free, 1)
+
+	second, err := order.NewOrder(uuid.New(), near, 15)
+	assert.NoError(err)
+	assert.NoError(free[0].TakeOrder(second))
+	assert.NoError(second.Assign(driver.ID()))
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Update(ctx, free[0]))
+	assert.NoError(uow.OrderRepository().Add(ctx, second))
+	assert.NoError(uow.Commit(ctx))
+
+	// Один шаг: курьер едет к ближайшему адресу и отдает заказ
+	command, err := NewMoveCouriersCommand()
+	assert.NoError(err)
+	handler, err := NewMoveCouriersCommandHandler(factory, services.NewRoutePlanner(kernel.DefaultArea()), kernel.DefaultArea())
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+
+	driver, err = uow.CourierRepository().Get(ctx, driver.ID())
+	assert.NoError(err)
+	assert.True(near.Equals(driver.Location()))
+	assert.Equal([]uuid.UUID{first.ID()}, driver.OrderIDs())
+	assert.Len(driver.Route(), 1)
+	assert.Equal(first.ID(), driver.Route()[0].OrderID())
+
+	got, err := uow.OrderRepository().Get(ctx, second.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusCompleted, got.Status())
+
+	got, err = uow.OrderRepository().Get(ctx, first.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusAssigned, got.Status())
+}
+
+func Test_MoveCouriersCommandWithPickup(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	start, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	warehouse, err := kernel.NewLocation(1, 3)
+	assert.NoError(err)
+	customer, err := kernel.NewLocation(1, 5)
+	assert.NoError(err)
+
+	walker, err := courier.NewCourier("walker", courier.TransportTypePedestrian, 2, start)
+	assert.NoError(err)
+	parcel, err := order.NewOrder(uuid.New(), customer, 1)
+	assert.NoError(err)
+	assert.NoError(parcel.SetPickup(warehouse))
+	assert.NoError(walker.TakeOrder(parcel))
+	assert.NoError(parcel.Assign(walker.ID()))
+
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, walker))
+	assert.NoError(uow.OrderRepository().Add(ctx, parcel))
+	assert.NoError(uow.Commit(ctx))
+
+	command, err := NewMoveCouriersCommand()
+	assert.NoError(err)
+	handler, err := NewMoveCouriersCommandHandler(factory, services.NewRoutePlanner(kernel.DefaultArea()), kernel.DefaultArea())
+	assert.NoError(err)
+
+	// Первый шаг: курьер приезжает в точку выдачи и забирает заказ
+	assert.NoError(handler.Handle(ctx, command))
+	walker, err = uow.CourierRepository().Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.True(warehouse.Equals(walker.Location()))
+	assert.False(walker.HasPickupStop(parcel.ID()))
+	got, err := uow.OrderRepository().Get(ctx, parcel.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusPickedUp, got.Status())
+	assert.True(warehouse.Equals(got.Pickup()))
+
+	// Второй шаг: курьер везет заказ клиенту
+	assert.NoError(handler.Handle(ctx, command))
+	walker, err = uow.CourierRepository().Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.True(customer.Equals(walker.Location()))
+	assert.Empty(walker.OrderIDs())
+	got, err = uow.OrderRepository().Get(ctx, parcel.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusCompleted, got.Status())
+}
+
+func Test_MoveCouriersCommandAwaitsConfirmation(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	start, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	door, err := kernel.NewLocation(1, 3)
+	assert.NoError(err)
+	next, err := kernel.NewLocation(1, 9)
+	assert.NoError(err)
+
+	// Курьер везет заказ с кодом подтверждения и еще один заказ дальше по маршруту
+	driver, err := courier.NewCourier("driver", courier.TransportTypePedestrian, 2, start)
+	assert.NoError(err)
+	assert.NoError(driver.AddStoragePlace("Багажник", courier.StoragePlaceKindAmbient, 20, 0))
+	confirmed, err := order.NewOrder(uuid.New(), door, 1)
+	assert.NoError(err)
+	_, err = confirmed.RequireConfirmation()
+	assert.NoError(err)
+	assert.NoError(driver.TakeOrder(confirmed))
+	assert.NoError(confirmed.Assign(driver.ID()))
+	other, err := order.NewOrder(uuid.New(), next, 15)
+	assert.NoError(err)
+	assert.NoError(driver.TakeOrder(other))
+	assert.NoError(other.Assign(driver.ID()))
+
+	factory, err := postgres.NewUnitOfWorkFactoryWithCipher(db, ddd.NewMediatr(), newTestCipher(t))
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, driver))
+	assert.NoError(uow.OrderRepository().Add(ctx, confirmed))
+	assert.NoError(uow.OrderRepository().Add(ctx, other))
+	assert.NoError(uow.Commit(ctx))
+
+	command, err := NewMoveCouriersCommand()
+	assert.NoError(err)
+	handler, err := NewMoveCouriersCommandHandler(factory, services.NewRoutePlanner(kernel.DefaultArea()), kernel.DefaultArea())
+	assert.NoError(err)
+
+	// Курьер приезжает к клиенту и ждет код, следующий шаг он стоит на месте
+	assert.NoError(handler.Handle(ctx, command))
+	got, err := uow.OrderRepository().Get(ctx, confirmed.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusAwaitingConfirmation, got.Status())
+	assert.NotNil(got.Confirmation().ArrivedAt())
+
+	assert.NoError(handler.Handle(ctx, command))
+	driver, err = uow.CourierRepository().Get(ctx, driver.ID())
+	assert.NoError(err)
+	assert.True(door.Equals(driver.Location()))
+	assert.Len(driver.OrderIDs(), 2)
+
+	// Клиент не подтвердил доставку вовремя, курьер едет к следующему заказу
+	flag, err := NewFlagOverdueConfirmationsCommandHandler(factory, time.Minute,
+		func() time.Time { return time.Now().Add(10 * time.Minute) })
+	assert.NoError(err)
+	flagCommand, err := NewFlagOverdueConfirmationsCommand()
+	assert.NoError(err)
+	assert.NoError(flag.Handle(ctx, flagCommand))
+
+	assert.NoError(handler.Handle(ctx, command))
+	driver, err = uow.CourierRepository().Get(ctx, driver.ID())
+	assert.NoError(err)
+	want, err := kernel.NewLocation(1, 5)
+	assert.NoError(err)
+	assert.True(want.Equals(driver.Location()))
+	assert.Equal([]uuid.UUID{other.ID()}, driver.OrderIDs())
+}
+
 func setupTest(t *testing.T) (context.Context, *gorm.DB, error) {
 	assert := assert.New(t)
 	ctx := context.Background()
@@ -77,10 +282,22 @@ func setupTest(t *testing.T) (context.Context, *gorm.DB, error) {
 	// Авто миграция (создаём таблицу)
 	err = db.AutoMigrate(&courierrepo.StoragePlaceDTO{})
 	assert.NoError(err)
+	err = db.AutoMigrate(&courierrepo.StoredOrderDTO{})
+	assert.NoError(err)
 	err = db.AutoMigrate(&courierrepo.CourierDTO{})
 	assert.NoError(err)
 	err = db.AutoMigrate(&orderrepo.OrderDTO{})
 	assert.NoError(err)
+	err = db.AutoMigrate(&outbox.MessageDTO{})
+	assert.NoError(err)
+	err = db.AutoMigrate(&inbox.MessageDTO{})
+	assert.NoError(err)
+	err = db.AutoMigrate(&statsrepo.CourierStatsDTO{})
+	assert.NoError(err)
+	err = db.AutoMigrate(&statsrepo.RatingDTO{})
+	assert.NoError(err)
+	err = db.AutoMigrate(&statsrepo.AppliedEventDTO{})
+	assert.NoError(err)
 
 	// Очистка выполняется после завершения теста
 	t.Cleanup(func() {
@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type RateDeliveryCommand struct {
+	orderID uuid.UUID
+	score   int
+	valid   bool
+}
+
+func NewRateDeliveryCommand(orderID uuid.UUID, score int) (RateDeliveryCommand, error) {
+	if orderID == uuid.Nil {
+		return RateDeliveryCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+	if err := courier.ValidateRating(score); err != nil {
+		return RateDeliveryCommand{}, err
+	}
+
+	return RateDeliveryCommand{orderID: orderID, score: score, valid: true}, nil
+}
+
+func (c RateDeliveryCommand) OrderID() uuid.UUID { return c.orderID }
+
+func (c RateDeliveryCommand) Score() int { return c.score }
+
+func (c RateDeliveryCommand) IsValid() bool { return c.valid }
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type RateDeliveryCommandHandler interface {
+	Handle(context.Context, RateDeliveryCommand) error
+}
+
+type rateDeliveryCommandHandler struct {
+	factory ports.UnitOfWorkFactory
+	stats   ports.CourierStatsRepository
+}
+
+func NewRateDeliveryCommandHandler(
+	factory ports.UnitOfWorkFactory,
+	stats ports.CourierStatsRepository,
+) (*rateDeliveryCommandHandler, error) {
+	if factory == nil {
+		return nil, errs.NewValueIsRequiredError("factory")
+	}
+	if stats == nil {
+		return nil, errs.NewValueIsRequiredError("stats")
+	}
+
+	return &rateDeliveryCommandHandler{factory: factory, stats: stats}, nil
+}
+
+func (h *rateDeliveryCommandHandler) Handle(ctx context.Context, command RateDeliveryCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	uow, err := h.factory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	delivered, err := uow.OrderRepository().Get(ctx, command.OrderID())
+	if err != nil {
+		return err
+	}
+	if delivered == nil {
+		return errs.NewObjectNotFoundError("order", command.OrderID())
+	}
+
+	// Оценить можно только доставленный заказ, оценка достается доставившему курьеру
+	if !delivered.Status().Equals(order.StatusCompleted) {
+		return errs.NewExpectationFailedError("order.status", delivered.Status(), order.StatusCompleted)
+	}
+	if delivered.CourierID() == nil {
+		return errs.NewValueIsRequiredError("order.courierID")
+	}
+
+	return h.stats.AddRating(ctx, delivered.ID(), *delivered.CourierID(), command.Score())
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"testing"
+
+	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/outbox"
+	"delivery/internal/adapters/out/postgres/statsrepo"
+	"delivery/internal/core/application/usecases/eventhandlers"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RateDeliveryCommand(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	stats, err := statsrepo.NewRepository(db)
+	assert.NoError(err)
+	statsHandler, err := eventhandlers.NewCourierStatsHandler(stats, kernel.DefaultArea())
+	assert.NoError(err)
+	statsMediatr := ddd.NewMediatr()
+	statsMediatr.Subscribe(statsHandler, eventhandlers.CourierStatsEvents()...)
+	registry := outbox.NewEventRegistry()
+	registry.Register(eventhandlers.CourierStatsEvents()...)
+	relay, err := outbox.NewRelay(db, registry, statsMediatr)
+	assert.NoError(err)
+
+	walker, err := courier.NewCourier("test", courier.TransportTypePedestrian, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+	delivered, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(delivered.Assign(walker.ID()))
+	assert.NoError(delivered.Complete())
+	pending, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, walker))
+	assert.NoError(uow.OrderRepository().Add(ctx, delivered))
+	assert.NoError(uow.OrderRepository().Add(ctx, pending))
+	assert.NoError(uow.Commit(ctx))
+
+	// Доставка учтена из outbox, время доставки известно
+	assert.NoError(relay.Process(ctx))
+	got, err := stats.Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.Equal(1, got.DeliveredOrders())
+	assert.Positive(got.AverageDeliveryTime())
+
+	// Повторно доставленное событие учитывается один раз
+	eventID := uuid.New()
+	assert.NoError(stats.AddDelivery(ctx, eventID, walker.ID(), 0))
+	assert.NoError(stats.AddDelivery(ctx, eventID, walker.ID(), 0))
+	got, err = stats.Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.Equal(2, got.DeliveredOrders())
+
+	handler, err := NewRateDeliveryCommandHandler(factory, stats)
+	assert.NoError(err)
+	command, err := NewRateDeliveryCommand(delivered.ID(), 4)
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+
+	// Повторно оценить нельзя, как и недоставленный заказ
+	assert.ErrorIs(handler.Handle(ctx, command), ports.ErrOrderAlreadyRated)
+	command, err = NewRateDeliveryCommand(pending.ID(), 5)
+	assert.NoError(err)
+	assert.ErrorIs(handler.Handle(ctx, command), errs.ErrExpectationFailed)
+	command, err = NewRateDeliveryCommand(uuid.New(), 5)
+	assert.NoError(err)
+	assert.ErrorIs(handler.Handle(ctx, command), errs.ErrObjectNotFound)
+
+	got, err = stats.Get(ctx, walker.ID())
+	assert.NoError(err)
+	rating, rated := got.Rating()
+	assert.True(rated)
+	assert.Equal(4.0, rating)
+}
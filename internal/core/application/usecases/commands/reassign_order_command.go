@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type ReassignOrderCommand struct {
+	orderID   uuid.UUID
+	courierID *uuid.UUID
+	valid     bool
+}
+
+// NewReassignOrderCommand makes a command which hands the order over to the courier
+// or returns it to the dispatch queue when courierID is nil.
+func NewReassignOrderCommand(orderID uuid.UUID, courierID *uuid.UUID) (ReassignOrderCommand, error) {
+	if orderID == uuid.Nil {
+		return ReassignOrderCommand{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if courierID != nil && *courierID == uuid.Nil {
+		return ReassignOrderCommand{}, errs.NewValueIsInvalidError("courierID")
+	}
+
+	return ReassignOrderCommand{orderID: orderID, courierID: courierID, valid: true}, nil
+}
+
+func (c ReassignOrderCommand) OrderID() uuid.UUID { return c.orderID }
+
+func (c ReassignOrderCommand) CourierID() *uuid.UUID { return c.courierID }
+
+func (c ReassignOrderCommand) IsValid() bool { return c.valid }
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type ReassignOrderCommandHandler interface {
+	Handle(context.Context, ReassignOrderCommand) error
+}
+
+type reassignOrderCommandHandler struct {
+	factory    ports.UnitOfWorkFactory
+	reassigner services.OrderReassigner
+}
+
+func NewReassignOrderCommandHandler(factory ports.UnitOfWorkFactory, reassigner services.OrderReassigner) (*reassignOrderCommandHandler, error) {
+	if factory == nil {
+		return nil, errs.NewValueIsRequiredError("factory")
+	}
+
+	if reassigner == nil {
+		return nil, errs.NewValueIsRequiredError("reassigner")
+	}
+
+	return &reassignOrderCommandHandler{factory: factory, reassigner: reassigner}, nil
+}
+
+func (h *reassignOrderCommandHandler) Handle(ctx context.Context, command ReassignOrderCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	uow, err := h.factory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	order, err := uow.OrderRepository().Get(ctx, command.OrderID())
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return errs.NewObjectNotFoundError("order", command.OrderID())
+	}
+	if order.CourierID() == nil {
+		return errs.NewValueIsRequiredError("order.courierID")
+	}
+
+	from, err := uow.CourierRepository().Get(ctx, *order.CourierID())
+	if err != nil {
+		return err
+	}
+
+	if command.CourierID() == nil {
+		if err = h.reassigner.Unassign(order, from); err != nil {
+			return err
+		}
+	} else {
+		to, err := uow.CourierRepository().Get(ctx, *command.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if err = h.reassigner.Reassign(order, from, to); err != nil {
+			return err
+		}
+
+		if err = uow.CourierRepository().Update(ctx, to); err != nil {
+			return err
+		}
+	}
+
+	if err = uow.OrderRepository().Update(ctx, order); err != nil {
+		return err
+	}
+
+	if err = uow.CourierRepository().Update(ctx, from); err != nil {
+		return err
+	}
+
+	return uow.Commit(ctx)
+}
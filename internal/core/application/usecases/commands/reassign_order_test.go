@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"testing"
+
+	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ReassignOrderCommand(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	from, err := courier.NewCourier("from", courier.TransportTypePedestrian, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+	to, err := courier.NewCourier("to", courier.TransportTypePedestrian, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+	assigned, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(from.TakeOrder(assigned))
+	assert.NoError(assigned.Assign(from.ID()))
+
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, from))
+	assert.NoError(uow.CourierRepository().Add(ctx, to))
+	assert.NoError(uow.OrderRepository().Add(ctx, assigned))
+	assert.NoError(uow.Commit(ctx))
+
+	handler, err := NewReassignOrderCommandHandler(factory, services.NewOrderReassigner())
+	assert.NoError(err)
+
+	// Передаем другому курьеру
+	toID := to.ID()
+	command, err := NewReassignOrderCommand(assigned.ID(), &toID)
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+
+	got, err := uow.OrderRepository().Get(ctx, assigned.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusAssigned, got.Status())
+	assert.Equal(to.ID(), *got.CourierID())
+
+	// Возвращаем в очередь
+	command, err = NewReassignOrderCommand(assigned.ID(), nil)
+	assert.NoError(err)
+	assert.NoError(handler.Handle(ctx, command))
+
+	got, err = uow.OrderRepository().Get(ctx, assigned.ID())
+	assert.NoError(err)
+	assert.Equal(order.StatusCreated, got.Status())
+	assert.Nil(got.CourierID())
+
+	to, err = uow.CourierRepository().Get(ctx, to.ID())
+	assert.NoError(err)
+	for _, sp := range to.StoragePlaces() {
+		assert.False(sp.IsOccupied())
+	}
+}
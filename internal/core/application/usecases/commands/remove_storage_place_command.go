@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type RemoveStoragePlaceCommand struct {
+	courierID      uuid.UUID
+	storagePlaceID uuid.UUID
+	valid          bool
+}
+
+func NewRemoveStoragePlaceCommand(courierID, storagePlaceID uuid.UUID) (RemoveStoragePlaceCommand, error) {
+	if courierID == uuid.Nil {
+		return RemoveStoragePlaceCommand{}, errs.NewValueIsRequiredError("courierID")
+	}
+
+	if storagePlaceID == uuid.Nil {
+		return RemoveStoragePlaceCommand{}, errs.NewValueIsRequiredError("storagePlaceID")
+	}
+
+	return RemoveStoragePlaceCommand{courierID: courierID, storagePlaceID: storagePlaceID, valid: true}, nil
+}
+
+func (c RemoveStoragePlaceCommand) CourierID() uuid.UUID { return c.courierID }
+
+func (c RemoveStoragePlaceCommand) StoragePlaceID() uuid.UUID { return c.storagePlaceID }
+
+func (c RemoveStoragePlaceCommand) IsValid() bool { return c.valid }
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type RemoveStoragePlaceCommandHandler interface {
+	Handle(context.Context, RemoveStoragePlaceCommand) error
+}
+
+type removeStoragePlaceCommandHandler struct {
+	factory ports.UnitOfWorkFactory
+}
+
+func NewRemoveStoragePlaceCommandHandler(factory ports.UnitOfWorkFactory) (*removeStoragePlaceCommandHandler, error) {
+	if factory == nil {
+		return nil, errs.NewValueIsRequiredError("factory")
+	}
+	return &removeStoragePlaceCommandHandler{factory: factory}, nil
+}
+
+func (h *removeStoragePlaceCommandHandler) Handle(ctx context.Context, command RemoveStoragePlaceCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	uow, err := h.factory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	courier, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+
+	if err = courier.RemoveStoragePlace(command.StoragePlaceID()); err != nil {
+		return err
+	}
+
+	if err = uow.CourierRepository().Update(ctx, courier); err != nil {
+		return err
+	}
+
+	return uow.Commit(ctx)
+}
@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type ReplayDeadLetterCommand struct {
+	deadLetterID uuid.UUID
+	valid        bool
+}
+
+func NewReplayDeadLetterCommand(deadLetterID uuid.UUID) (ReplayDeadLetterCommand, error) {
+	if deadLetterID == uuid.Nil {
+		return ReplayDeadLetterCommand{}, errs.NewValueIsRequiredError("deadLetterID")
+	}
+
+	return ReplayDeadLetterCommand{deadLetterID: deadLetterID, valid: true}, nil
+}
+
+func (c ReplayDeadLetterCommand) DeadLetterID() uuid.UUID { return c.deadLetterID }
+
+func (c ReplayDeadLetterCommand) IsValid() bool { return c.valid }
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type ReplayDeadLetterCommandHandler interface {
+	Handle(context.Context, ReplayDeadLetterCommand) error
+}
+
+type replayDeadLetterCommandHandler struct {
+	repository ports.DeadLetterRepository
+	replayers  map[string]ports.MessageReplayer
+}
+
+func NewReplayDeadLetterCommandHandler(
+	repository ports.DeadLetterRepository,
+	replayers ...ports.MessageReplayer,
+) (*replayDeadLetterCommandHandler, error) {
+	if repository == nil {
+		return nil, errs.NewValueIsRequiredError("repository")
+	}
+
+	bySource := make(map[string]ports.MessageReplayer, len(replayers))
+	for _, replayer := range replayers {
+		if replayer == nil {
+			return nil, errs.NewValueIsRequiredError("replayer")
+		}
+		bySource[replayer.Source()] = replayer
+	}
+
+	return &replayDeadLetterCommandHandler{repository: repository, replayers: bySource}, nil
+}
+
+func (h *replayDeadLetterCommandHandler) Handle(ctx context.Context, command ReplayDeadLetterCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	deadLetter, err := h.repository.Get(ctx, command.DeadLetterID())
+	if err != nil {
+		return err
+	}
+
+	if deadLetter.IsReplayed() {
+		return errs.NewExpectationFailedError("replayedAtUtc", deadLetter.ReplayedAtUtc, nil)
+	}
+
+	replayer, ok := h.replayers[deadLetter.Source]
+	if !ok {
+		return errs.NewObjectNotFoundError("replayer", deadLetter.Source)
+	}
+
+	deadLetter.Attempts++
+	if err := replayer.Replay(ctx, deadLetter); err != nil {
+		deadLetter.Err = err
+		if updateErr := h.repository.Update(ctx, deadLetter); updateErr != nil {
+			return updateErr
+		}
+		return err
+	}
+
+	replayedAt := time.Now().UTC()
+	deadLetter.ReplayedAtUtc = &replayedAt
+	return h.repository.Update(ctx, deadLetter)
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/adapters/out/inmemory"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type messageReplayerStub struct {
+	source  string
+	err     error
+	replays []ports.DeadLetter
+}
+
+func (r *messageReplayerStub) Source() string { return r.source }
+
+func (r *messageReplayerStub) Replay(_ context.Context, deadLetter ports.DeadLetter) error {
+	r.replays = append(r.replays, deadLetter)
+	return r.err
+}
+
+func Test_ReplayDeadLetterCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		replayErr error
+		want      error
+		replayed  bool
+	}{
+		{name: "good", source: "basket.confirmed", replayed: true},
+		{name: "bad replay failed", source: "basket.confirmed", replayErr: errs.NewValueIsInvalidError("volume"), want: errs.ErrValueIsInvalid},
+		{name: "bad unknown source", source: "unknown", want: errs.ErrObjectNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			ctx := context.Background()
+
+			repository := inmemory.NewDeadLetterRepository()
+			deadLetter := ports.DeadLetter{
+				ID:       uuid.New(),
+				Source:   tt.source,
+				Payload:  []byte("{}"),
+				Err:      errors.New("failed"),
+				Attempts: 3,
+			}
+			assert.NoError(repository.Add(ctx, deadLetter))
+
+			replayer := &messageReplayerStub{source: "basket.confirmed", err: tt.replayErr}
+			handler, err := NewReplayDeadLetterCommandHandler(repository, replayer)
+			assert.NoError(err)
+
+			command, err := NewReplayDeadLetterCommand(deadLetter.ID)
+			assert.NoError(err)
+
+			err = handler.Handle(ctx, command)
+			assert.ErrorIs(err, tt.want)
+
+			got, err := repository.Get(ctx, deadLetter.ID)
+			assert.NoError(err)
+			assert.Equal(tt.replayed, got.IsReplayed())
+			if tt.replayErr != nil {
+				assert.ErrorIs(got.Err, tt.want)
+				assert.Equal(4, got.Attempts)
+			}
+
+			// Повторно не воспроизводится
+			if tt.replayed {
+				assert.ErrorIs(handler.Handle(ctx, command), errs.ErrExpectationFailed)
+				assert.Len(replayer.replays, 1)
+			}
+		})
+	}
+}
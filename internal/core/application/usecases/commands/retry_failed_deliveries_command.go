@@ -0,0 +1,9 @@
+package commands
+
+type RetryFailedDeliveriesCommand struct{ valid bool }
+
+func NewRetryFailedDeliveriesCommand() (RetryFailedDeliveriesCommand, error) {
+	return RetryFailedDeliveriesCommand{valid: true}, nil
+}
+
+func (c RetryFailedDeliveriesCommand) IsValid() bool { return c.valid }
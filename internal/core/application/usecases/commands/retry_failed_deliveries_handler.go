@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type RetryFailedDeliveriesCommandHandler interface {
+	Handle(context.Context, RetryFailedDeliveriesCommand) error
+}
+
+type retryFailedDeliveriesCommandHandler struct {
+	factory         ports.UnitOfWorkFactory
+	maxRedeliveries int
+}
+
+// NewRetryFailedDeliveriesCommandHandler makes the handler which returns failed orders
+// to dispatch up to maxRedeliveries times and then returns them to sender.
+func NewRetryFailedDeliveriesCommandHandler(
+	factory ports.UnitOfWorkFactory,
+	maxRedeliveries int,
+) (*retryFailedDeliveriesCommandHandler, error) {
+	if factory == nil {
+		return nil, errs.NewValueIsRequiredError("factory")
+	}
+	if maxRedeliveries < 0 {
+		return nil, errs.NewValueIsInvalidError("maxRedeliveries")
+	}
+
+	return &retryFailedDeliveriesCommandHandler{factory: factory, maxRedeliveries: maxRedeliveries}, nil
+}
+
+func (h *retryFailedDeliveriesCommandHandler) Handle(ctx context.Context, command RetryFailedDeliveriesCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsRequiredError("command")
+	}
+
+	uow, err := h.factory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	failed, err := uow.OrderRepository().GetAllFailed(ctx)
+	if err != nil {
+		return err
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	for _, item := range failed {
+		if item.Failure().CanRedeliver(h.maxRedeliveries) {
+			err = item.Redeliver(h.maxRedeliveries)
+		} else {
+			err = item.ReturnToSender()
+		}
+		if err != nil {
+			return err
+		}
+		if err = uow.OrderRepository().Update(ctx, item); err != nil {
+			return err
+		}
+	}
+
+	return uow.Commit(ctx)
+}
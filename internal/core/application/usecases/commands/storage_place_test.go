@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"testing"
+
+	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StoragePlaceCommands(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	walker, err := courier.NewCourier("test", courier.TransportTypePedestrian, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+	parcel, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(walker.TakeOrder(parcel))
+	bag := walker.StoragePlaces()[0]
+	assert.True(bag.Contains(parcel.ID()))
+
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	assert.NoError(err)
+	uow, err := factory.New(ctx)
+	assert.NoError(err)
+	uow.Begin(ctx)
+	assert.NoError(uow.CourierRepository().Add(ctx, walker))
+	assert.NoError(uow.Commit(ctx))
+
+	// Выдаем холодильник
+	add, err := NewAddStoragePlaceCommandHandler(factory)
+	assert.NoError(err)
+	addCommand, err := NewAddStoragePlaceCommand(walker.ID(), "Холодильник", courier.StoragePlaceKindRefrigerated, 10, 5000)
+	assert.NoError(err)
+	assert.NoError(add.Handle(ctx, addCommand))
+
+	walker, err = uow.CourierRepository().Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.Len(walker.StoragePlaces(), 2)
+	var fridge courier.StoragePlace
+	for _, place := range walker.StoragePlaces() {
+		if place.ID() != bag.ID() {
+			fridge = place
+		}
+	}
+	assert.Equal(courier.StoragePlaceKindRefrigerated, fridge.Kind())
+	assert.Equal(5000, fridge.MaxWeight())
+
+	// Сумку с заказом снять нельзя, свободный холодильник - можно
+	remove, err := NewRemoveStoragePlaceCommandHandler(factory)
+	assert.NoError(err)
+	removeCommand, err := NewRemoveStoragePlaceCommand(walker.ID(), bag.ID())
+	assert.NoError(err)
+	assert.ErrorIs(remove.Handle(ctx, removeCommand), courier.ErrStoragePlaceIsOccupied)
+
+	removeCommand, err = NewRemoveStoragePlaceCommand(walker.ID(), fridge.ID())
+	assert.NoError(err)
+	assert.NoError(remove.Handle(ctx, removeCommand))
+	assert.ErrorIs(remove.Handle(ctx, removeCommand), errs.ErrObjectNotFound)
+
+	walker, err = uow.CourierRepository().Get(ctx, walker.ID())
+	assert.NoError(err)
+	assert.Len(walker.StoragePlaces(), 1)
+	assert.True(walker.StoragePlaces()[0].Contains(parcel.ID()))
+}
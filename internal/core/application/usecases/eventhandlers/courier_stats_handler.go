@@ -0,0 +1,76 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.EventHandler = (*courierStatsHandler)(nil)
+
+type courierStatsHandler struct {
+	repository ports.CourierStatsRepository
+	area       kernel.Area
+}
+
+// NewCourierStatsHandler accumulates delivered orders and travelled distance of couriers.
+// It is fed from the outbox, the distance is converted by the area into its grid steps.
+func NewCourierStatsHandler(repository ports.CourierStatsRepository, area kernel.Area) (ddd.EventHandler, error) {
+	if repository == nil {
+		return nil, errs.NewValueIsRequiredError("repository")
+	}
+	if !area.IsValid() {
+		return nil, errs.NewValueIsRequiredError("area")
+	}
+	return &courierStatsHandler{repository: repository, area: area}, nil
+}
+
+// CourierStatsEvents returns the events the handler should be subscribed to.
+func CourierStatsEvents() []ddd.DomainEvent {
+	return []ddd.DomainEvent{
+		courier.CourierMoved{},
+		order.OrderCompleted{},
+	}
+}
+
+func (h *courierStatsHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	if event == nil {
+		return errs.NewValueIsRequiredError("event")
+	}
+
+	switch e := event.(type) {
+	case courier.CourierMoved:
+		return h.handleMoved(ctx, e)
+	case order.OrderCompleted:
+		// Заказ без курьера статистику не меняет
+		if e.CourierID == uuid.Nil {
+			return nil
+		}
+		return h.repository.AddDelivery(ctx, e.ID, e.CourierID, e.DeliveryTime)
+	}
+	return errs.NewValueIsInvalidError("event")
+}
+
+func (h *courierStatsHandler) handleMoved(ctx context.Context, moved courier.CourierMoved) error {
+	from, err := moved.From()
+	if err != nil {
+		return err
+	}
+	to, err := moved.To()
+	if err != nil {
+		return err
+	}
+
+	distance, err := h.area.Distance(from, to)
+	if err != nil {
+		return err
+	}
+	return h.repository.AddDistance(ctx, moved.ID, moved.CourierID, distance)
+}
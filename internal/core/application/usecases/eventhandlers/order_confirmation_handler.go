@@ -0,0 +1,47 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/labstack/gommon/log"
+)
+
+var _ ddd.EventHandler = (*orderConfirmationHandler)(nil)
+
+type orderConfirmationHandler struct {
+	sender ports.ConfirmationCodeSender
+}
+
+// NewOrderConfirmationHandler sends issued confirmation codes to the customer and reports
+// deliveries the customer has not confirmed in time. It is fed from the outbox, so a code
+// is sent at least once, see order.OrderConfirmationCodeIssued.
+func NewOrderConfirmationHandler(sender ports.ConfirmationCodeSender) (ddd.EventHandler, error) {
+	if sender == nil {
+		return nil, errs.NewValueIsRequiredError("sender")
+	}
+	return &orderConfirmationHandler{sender: sender}, nil
+}
+
+func (h *orderConfirmationHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	if event == nil {
+		return errs.NewValueIsRequiredError("event")
+	}
+
+	switch e := event.(type) {
+	case order.OrderConfirmationCodeIssued:
+		if err := h.sender.Send(ctx, e.OrderID, e.Code); err != nil {
+			return err
+		}
+		log.Infof("order %s: confirmation code sent to the customer", e.OrderID)
+	case order.OrderConfirmationOverdue:
+		log.Warnf("order %s is not confirmed in time, delivery failed: courier %s waited since %s", e.OrderID, e.CourierID, e.ArrivedAt)
+	default:
+		return errs.NewValueIsInvalidError("event")
+	}
+	return nil
+}
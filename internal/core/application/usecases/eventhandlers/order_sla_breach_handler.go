@@ -0,0 +1,35 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/labstack/gommon/log"
+)
+
+var _ ddd.EventHandler = (*orderSLABreachHandler)(nil)
+
+type orderSLABreachHandler struct{}
+
+// NewOrderSLABreachHandler reports orders which are expected to be delivered after the promised deadline.
+func NewOrderSLABreachHandler() ddd.EventHandler {
+	return &orderSLABreachHandler{}
+}
+
+func (h *orderSLABreachHandler) Handle(_ context.Context, event ddd.DomainEvent) error {
+	if event == nil {
+		return errs.NewValueIsRequiredError("event")
+	}
+
+	breach, ok := event.(order.OrderSLABreachPredicted)
+	if !ok {
+		return errs.NewValueIsInvalidError("event")
+	}
+
+	log.Warnf("order %s will breach SLA: promised by %s, expected at %s (courier %s, late by %s)",
+		breach.OrderID, breach.PromisedBy, breach.ExpectedAt, breach.CourierID, breach.Delay())
+	return nil
+}
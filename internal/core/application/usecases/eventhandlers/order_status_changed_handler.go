@@ -0,0 +1,57 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+var _ ddd.EventHandler = (*orderStatusChangedHandler)(nil)
+
+// orderStatusChangedEvent is implemented by every order lifecycle event.
+type orderStatusChangedEvent interface {
+	ddd.DomainEvent
+	GetOrderID() uuid.UUID
+	GetOrderStatus() order.Status
+}
+
+type orderStatusChangedHandler struct{}
+
+func NewOrderStatusChangedHandler() ddd.EventHandler {
+	return &orderStatusChangedHandler{}
+}
+
+// OrderStatusChangedEvents returns the events the handler should be subscribed to.
+func OrderStatusChangedEvents() []ddd.DomainEvent {
+	return []ddd.DomainEvent{
+		order.OrderCreated{},
+		order.OrderAssigned{},
+		order.OrderPickedUp{},
+		order.OrderAwaitingConfirmation{},
+		order.OrderDeliveryFailed{},
+		order.OrderRedeliveryScheduled{},
+		order.OrderReturnedToSender{},
+		order.OrderCompleted{},
+		order.OrderCancelled{},
+		order.OrderUnassigned{},
+	}
+}
+
+func (h *orderStatusChangedHandler) Handle(_ context.Context, event ddd.DomainEvent) error {
+	if event == nil {
+		return errs.NewValueIsRequiredError("event")
+	}
+
+	orderEvent, ok := event.(orderStatusChangedEvent)
+	if !ok {
+		return errs.NewValueIsInvalidError("event")
+	}
+
+	log.Infof("order %s status changed to %s", orderEvent.GetOrderID(), orderEvent.GetOrderStatus())
+	return nil
+}
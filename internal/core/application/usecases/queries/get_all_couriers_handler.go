@@ -5,6 +5,7 @@ import (
 
 	"delivery/internal/pkg/errs"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -31,12 +32,54 @@ func (h *getAllCouriersQueryHandler) Handle(ctx context.Context, query GetAllCou
 	var couriers []Courier
 
 	err := h.db.WithContext(ctx).
-		Raw("SELECT id,name, location_x, location_y FROM couriers").
+		Raw("SELECT id,name, location_x, location_y, location_lat, location_lon, availability, transport_type, route FROM couriers").
 		Scan(&couriers).
 		Error
 	if err != nil {
 		return GetAllCouriersResponse{}, err
 	}
 
+	places, err := h.storagePlaces(ctx)
+	if err != nil {
+		return GetAllCouriersResponse{}, err
+	}
+	for i := range couriers {
+		couriers[i].StoragePlaces = places[couriers[i].ID]
+	}
+
 	return GetAllCouriersResponse{Couriers: couriers}, nil
 }
+
+// storagePlaces returns the storage places with their orders grouped by courier.
+func (h *getAllCouriersQueryHandler) storagePlaces(ctx context.Context) (map[uuid.UUID][]StoragePlace, error) {
+	var places []StoragePlace
+	err := h.db.WithContext(ctx).
+		Raw("SELECT sp.id, sp.courier_id, sp.name, sp.kind, sp.total_volume, sp.max_weight, " +
+			"COALESCE((SELECT SUM(spo.volume) FROM storage_place_orders spo WHERE spo.storage_place_id = sp.id), 0) AS occupied_volume " +
+			"FROM storage_places sp ORDER BY sp.name").
+		Scan(&places).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	var stored []storedOrder
+	err = h.db.WithContext(ctx).
+		Raw("SELECT order_id, storage_place_id FROM storage_place_orders").
+		Scan(&stored).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	orders := make(map[uuid.UUID][]uuid.UUID)
+	for _, item := range stored {
+		orders[item.StoragePlaceID] = append(orders[item.StoragePlaceID], item.OrderID)
+	}
+
+	res := make(map[uuid.UUID][]StoragePlace)
+	for _, place := range places {
+		place.OrderIDs = orders[place.ID]
+		res[place.CourierID] = append(res[place.CourierID], place)
+	}
+	return res, nil
+}
@@ -9,9 +9,35 @@ type GetAllCouriersResponse struct {
 }
 
 type Courier struct {
-	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
-	Name     string
-	Location Location `gorm:"embedded;embeddedPrefix:location_"`
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name          string
+	Location      Location `gorm:"embedded;embeddedPrefix:location_"`
+	Availability  string
+	TransportType string
+	Route         []RouteStop    `gorm:"serializer:json"`
+	StoragePlaces []StoragePlace `gorm:"-"`
 }
 
 func (Courier) TableName() string { return "couriers" }
+
+type StoragePlace struct {
+	ID             uuid.UUID
+	CourierID      uuid.UUID
+	Name           string
+	Kind           string
+	TotalVolume    int
+	OccupiedVolume int
+	MaxWeight      int
+	OrderIDs       []uuid.UUID `gorm:"-"`
+}
+
+type storedOrder struct {
+	OrderID        uuid.UUID
+	StoragePlaceID uuid.UUID
+}
+
+type RouteStop struct {
+	OrderID  uuid.UUID `json:"orderId"`
+	Kind     string    `json:"kind"`
+	Location Location  `json:"location"`
+}
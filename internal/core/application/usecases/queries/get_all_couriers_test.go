@@ -6,6 +6,7 @@ import (
 	"delivery/internal/adapters/out/postgres"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/ddd"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -16,14 +17,14 @@ func Test_GetAllCouriersQuery(t *testing.T) {
 	ctx, db, err := setupTest(t)
 	assert.NoError(err)
 
-	factory, err := postgres.NewUnitOfWorkFactory(db)
+	factory, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
 	assert.NoError(err)
 
 	uow, err := factory.New(ctx)
 	assert.NoError(err)
 	uow.Begin(ctx)
 
-	courier, err := courier.NewCourier("test", 5, kernel.NewRandomLocation())
+	courier, err := courier.NewCourier("test", courier.TransportTypeCar, 5, kernel.NewRandomLocation())
 	assert.NoError(err)
 
 	repo := uow.CourierRepository()
@@ -40,4 +41,5 @@ func Test_GetAllCouriersQuery(t *testing.T) {
 	assert.NoError(err)
 
 	assert.Len(res.Couriers, 1)
+	assert.Len(res.Couriers[0].StoragePlaces, len(courier.StoragePlaces()))
 }
@@ -0,0 +1,76 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type GetCourierStatsQueryHandler interface {
+	Handle(context.Context, GetCourierStatsQuery) (GetCourierStatsResponse, error)
+}
+
+func NewGetCourierStatsQueryHandler(db *gorm.DB) (*getCourierStatsQueryHandler, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+	return &getCourierStatsQueryHandler{db: db}, nil
+}
+
+type getCourierStatsQueryHandler struct {
+	db *gorm.DB
+}
+
+func (h *getCourierStatsQueryHandler) Handle(ctx context.Context, query GetCourierStatsQuery) (GetCourierStatsResponse, error) {
+	if !query.IsValid() {
+		return GetCourierStatsResponse{}, errs.NewValueIsRequiredError("query")
+	}
+
+	var row struct {
+		CourierID       uuid.UUID
+		DeliveredOrders int
+		Distance        int
+		DeliveryTime    time.Duration
+		TimedDeliveries int
+		Rating          *float64
+		RatingCount     int
+	}
+	result := h.db.WithContext(ctx).
+		Raw(`SELECT c.id AS courier_id,
+				COALESCE(s.delivered_orders, 0) AS delivered_orders,
+				COALESCE(s.distance, 0) AS distance,
+				COALESCE(s.delivery_time, 0) AS delivery_time,
+				COALESCE(s.timed_deliveries, 0) AS timed_deliveries,
+				r.rating,
+				COALESCE(r.rating_count, 0) AS rating_count
+			FROM couriers c
+			LEFT JOIN courier_stats s ON s.courier_id = c.id
+			LEFT JOIN (
+				SELECT courier_id, AVG(score)::float8 AS rating, COUNT(*) AS rating_count
+				FROM courier_ratings GROUP BY courier_id
+			) r ON r.courier_id = c.id
+			WHERE c.id = ?`, query.CourierID()).
+		Scan(&row)
+	if result.Error != nil {
+		return GetCourierStatsResponse{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return GetCourierStatsResponse{}, errs.NewObjectNotFoundError("courier", query.CourierID())
+	}
+
+	response := GetCourierStatsResponse{
+		CourierID:       row.CourierID,
+		DeliveredOrders: row.DeliveredOrders,
+		Distance:        row.Distance,
+		Rating:          row.Rating,
+		RatingCount:     row.RatingCount,
+	}
+	if row.TimedDeliveries > 0 {
+		response.AverageDeliveryTime = row.DeliveryTime / time.Duration(row.TimedDeliveries)
+	}
+	return response, nil
+}
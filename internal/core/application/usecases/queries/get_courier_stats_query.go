@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type GetCourierStatsQuery struct {
+	courierID uuid.UUID
+	valid     bool
+}
+
+func NewGetCourierStatsQuery(courierID uuid.UUID) (GetCourierStatsQuery, error) {
+	if courierID == uuid.Nil {
+		return GetCourierStatsQuery{}, errs.NewValueIsRequiredError("courierID")
+	}
+	return GetCourierStatsQuery{courierID: courierID, valid: true}, nil
+}
+
+func (q GetCourierStatsQuery) CourierID() uuid.UUID { return q.courierID }
+
+func (q GetCourierStatsQuery) IsValid() bool { return q.valid }
@@ -0,0 +1,17 @@
+package queries
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GetCourierStatsResponse struct {
+	CourierID           uuid.UUID
+	DeliveredOrders     int
+	Distance            int
+	AverageDeliveryTime time.Duration
+	// Rating is nil until the first customer rates the courier
+	Rating      *float64
+	RatingCount int
+}
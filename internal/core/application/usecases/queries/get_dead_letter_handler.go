@@ -0,0 +1,47 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+)
+
+type GetDeadLetterQueryHandler interface {
+	Handle(context.Context, GetDeadLetterQuery) (GetDeadLetterResponse, error)
+}
+
+func NewGetDeadLetterQueryHandler(db *gorm.DB) (*getDeadLetterQueryHandler, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+	return &getDeadLetterQueryHandler{db: db}, nil
+}
+
+type getDeadLetterQueryHandler struct {
+	db *gorm.DB
+}
+
+func (h *getDeadLetterQueryHandler) Handle(ctx context.Context, query GetDeadLetterQuery) (GetDeadLetterResponse, error) {
+	if !query.IsValid() {
+		return GetDeadLetterResponse{}, errs.NewValueIsRequiredError("query")
+	}
+
+	var row struct {
+		DeadLetter `gorm:"embedded"`
+		Payload    []byte
+	}
+	result := h.db.WithContext(ctx).
+		Raw(`SELECT id, message_id, source, error_kind, error_message, attempts, created_at_utc, replayed_at_utc, payload
+			FROM dead_letters WHERE id = ?`, query.DeadLetterID()).
+		Scan(&row)
+	if result.Error != nil {
+		return GetDeadLetterResponse{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return GetDeadLetterResponse{}, errs.NewObjectNotFoundError("deadLetter", query.DeadLetterID())
+	}
+
+	return GetDeadLetterResponse{DeadLetter: row.DeadLetter, Payload: row.Payload}, nil
+}
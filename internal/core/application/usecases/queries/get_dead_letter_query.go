@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type GetDeadLetterQuery struct {
+	deadLetterID uuid.UUID
+	valid        bool
+}
+
+func NewGetDeadLetterQuery(deadLetterID uuid.UUID) (GetDeadLetterQuery, error) {
+	if deadLetterID == uuid.Nil {
+		return GetDeadLetterQuery{}, errs.NewValueIsRequiredError("deadLetterID")
+	}
+	return GetDeadLetterQuery{deadLetterID: deadLetterID, valid: true}, nil
+}
+
+func (q GetDeadLetterQuery) DeadLetterID() uuid.UUID { return q.deadLetterID }
+
+func (q GetDeadLetterQuery) IsValid() bool { return q.valid }
@@ -0,0 +1,6 @@
+package queries
+
+type GetDeadLetterResponse struct {
+	DeadLetter DeadLetter
+	Payload    []byte
+}
@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+)
+
+type GetDeadLettersQueryHandler interface {
+	Handle(context.Context, GetDeadLettersQuery) (GetDeadLettersResponse, error)
+}
+
+func NewGetDeadLettersQueryHandler(db *gorm.DB) (*getDeadLettersQueryHandler, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+	return &getDeadLettersQueryHandler{db: db}, nil
+}
+
+type getDeadLettersQueryHandler struct {
+	db *gorm.DB
+}
+
+func (h *getDeadLettersQueryHandler) Handle(ctx context.Context, query GetDeadLettersQuery) (GetDeadLettersResponse, error) {
+	if !query.IsValid() {
+		return GetDeadLettersResponse{}, errs.NewValueIsRequiredError("query")
+	}
+
+	var deadLetters []DeadLetter
+	err := h.db.WithContext(ctx).
+		Raw(`SELECT id, message_id, source, error_kind, error_message, attempts, created_at_utc, replayed_at_utc
+			FROM dead_letters ORDER BY created_at_utc DESC`).
+		Scan(&deadLetters).
+		Error
+	if err != nil {
+		return GetDeadLettersResponse{}, err
+	}
+
+	return GetDeadLettersResponse{DeadLetters: deadLetters}, nil
+}
@@ -0,0 +1,9 @@
+package queries
+
+type GetDeadLettersQuery struct{ valid bool }
+
+func NewGetDeadLettersQuery() (GetDeadLettersQuery, error) {
+	return GetDeadLettersQuery{valid: true}, nil
+}
+
+func (q GetDeadLettersQuery) IsValid() bool { return q.valid }
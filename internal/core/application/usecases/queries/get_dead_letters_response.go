@@ -0,0 +1,24 @@
+package queries
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GetDeadLettersResponse struct {
+	DeadLetters []DeadLetter
+}
+
+type DeadLetter struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
+	MessageID     string
+	Source        string
+	ErrorKind     string
+	ErrorMessage  string
+	Attempts      int
+	CreatedAtUtc  time.Time
+	ReplayedAtUtc *time.Time
+}
+
+func (DeadLetter) TableName() string { return "dead_letters" }
@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/deadletter"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetDeadLettersQuery(t *testing.T) {
+	assert := assert.New(t)
+	// Инициализируем окружение
+	ctx, db, err := setupTest(t)
+	assert.NoError(err)
+
+	repository, err := deadletter.NewRepository(db)
+	assert.NoError(err)
+
+	deadLetter := ports.DeadLetter{
+		ID:           uuid.New(),
+		MessageID:    "basket.confirmed/0/1",
+		Source:       "basket.confirmed",
+		Payload:      []byte(`{"Volume":0}`),
+		Err:          errs.NewValueIsRequiredError("volume"),
+		Attempts:     5,
+		CreatedAtUtc: time.Now().UTC(),
+	}
+	assert.NoError(repository.Add(ctx, deadLetter))
+
+	// Список
+	listHandler, err := NewGetDeadLettersQueryHandler(db)
+	assert.NoError(err)
+	listQuery, err := NewGetDeadLettersQuery()
+	assert.NoError(err)
+
+	list, err := listHandler.Handle(ctx, listQuery)
+	assert.NoError(err)
+	assert.Len(list.DeadLetters, 1)
+	assert.Equal(errs.KindValueIsRequired, list.DeadLetters[0].ErrorKind)
+
+	// Одно письмо с содержимым
+	handler, err := NewGetDeadLetterQueryHandler(db)
+	assert.NoError(err)
+	query, err := NewGetDeadLetterQuery(deadLetter.ID)
+	assert.NoError(err)
+
+	res, err := handler.Handle(ctx, query)
+	assert.NoError(err)
+	assert.Equal(deadLetter.ID, res.DeadLetter.ID)
+	assert.Equal(deadLetter.Payload, res.Payload)
+
+	// Тип ошибки сохраняется
+	got, err := repository.Get(ctx, deadLetter.ID)
+	assert.NoError(err)
+	assert.ErrorIs(got.Err, errs.ErrValueIsRequired)
+
+	query, err = NewGetDeadLetterQuery(uuid.New())
+	assert.NoError(err)
+	_, err = handler.Handle(ctx, query)
+	assert.ErrorIs(err, errs.ErrObjectNotFound)
+}
@@ -31,7 +31,8 @@ func (h *getIncompleteOrdersHandler) Handle(ctx context.Context, query GetIncomp
 
 	var orders []Order
 	err := h.db.WithContext(ctx).
-		Raw("SELECT id, location_x, location_y FROM orders where status!=?", order.StatusCompleted).
+		Raw("SELECT id, location_x, location_y, location_lat, location_lon, pickup_x, pickup_y, pickup_lat, pickup_lon, status, failure_reason, failed_attempts FROM orders where status NOT IN ?",
+			[]order.Status{order.StatusCompleted, order.StatusCancelled, order.StatusReturnedToSender}).
 		Scan(&orders).
 		Error
 	if err != nil {
@@ -11,6 +11,17 @@ type GetIncompleteOrdersResponse struct {
 type Order struct {
 	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
 	Location Location  `gorm:"embedded;embeddedPrefix:location_"`
+	Pickup   Pickup    `gorm:"embedded;embeddedPrefix:pickup_"`
+	Status   string
+	// Причина последней неудачной попытки, пустая если вручить не пытались
+	FailureReason  string
+	FailedAttempts int
+}
+
+// Pickup is empty for orders created before the pickup leg.
+type Pickup struct {
+	X, Y     *int
+	Lat, Lon *float64
 }
 
 func (Order) TableName() string { return "orders" }
@@ -6,9 +6,13 @@ import (
 
 	"delivery/internal/adapters/out/postgres"
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/deadletter"
+	"delivery/internal/adapters/out/postgres/inbox"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outbox"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/testcnts"
 
 	"github.com/google/uuid"
@@ -23,7 +27,7 @@ func Test_GetIncompleteOrdersQuery(t *testing.T) {
 	ctx, db, err := setupTest(t)
 	assert.NoError(err)
 
-	uowf, err := postgres.NewUnitOfWorkFactory(db)
+	uowf, err := postgres.NewUnitOfWorkFactory(db, ddd.NewMediatr())
 	assert.NoError(err)
 
 	uow, err := uowf.New(ctx)
@@ -63,10 +67,18 @@ func setupTest(t *testing.T) (context.Context, *gorm.DB, error) {
 	// Авто миграция (создаём таблицу)
 	err = db.AutoMigrate(&courierrepo.StoragePlaceDTO{})
 	assert.NoError(err)
+	err = db.AutoMigrate(&courierrepo.StoredOrderDTO{})
+	assert.NoError(err)
 	err = db.AutoMigrate(&courierrepo.CourierDTO{})
 	assert.NoError(err)
 	err = db.AutoMigrate(&orderrepo.OrderDTO{})
 	assert.NoError(err)
+	err = db.AutoMigrate(&outbox.MessageDTO{})
+	assert.NoError(err)
+	err = db.AutoMigrate(&inbox.MessageDTO{})
+	assert.NoError(err)
+	err = db.AutoMigrate(&deadletter.DeadLetterDTO{})
+	assert.NoError(err)
 
 	// Очистка выполняется после завершения теста
 	t.Cleanup(func() {
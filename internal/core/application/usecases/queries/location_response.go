@@ -1,3 +1,6 @@
 package queries
 
-type Location struct{ X, Y int }
+type Location struct {
+	X, Y     int
+	Lat, Lon *float64
+}
@@ -0,0 +1,30 @@
+package courier
+
+const (
+	AvailabilityEmpty   Availability = ""
+	AvailabilityOnline  Availability = "Online"
+	AvailabilityOffline Availability = "Offline"
+	AvailabilityOnBreak Availability = "OnBreak"
+)
+
+type Availability string
+
+func (a Availability) Equals(other Availability) bool {
+	return a == other
+}
+
+func (a Availability) IsEmpty() bool {
+	return a == AvailabilityEmpty
+}
+
+func (a Availability) IsValid() bool {
+	switch a {
+	case AvailabilityOnline, AvailabilityOffline, AvailabilityOnBreak:
+		return true
+	}
+	return false
+}
+
+func (a Availability) String() string {
+	return string(a)
+}
@@ -3,6 +3,7 @@ package courier
 import (
 	"errors"
 	"math"
+	"slices"
 
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
@@ -12,23 +13,53 @@ import (
 	"github.com/google/uuid"
 )
 
-var ErrNoSuitableStoragePlace = errors.New("no suitable storage place")
+var (
+	ErrNoSuitableStoragePlace = errors.New("no suitable storage place")
+	ErrCourierIsCarryingOrder = errors.New("courier is carrying an order")
+	ErrCourierIsNotAvailable  = errors.New("courier is not available for new orders")
+)
 
 type Courier struct {
 	baseAggregate *ddd.BaseAggregate[uuid.UUID]
 	name          string
+	transport     TransportType
 	speed         int
 	location      kernel.Location
+	availability  Availability
 	storagePlaces []*StoragePlace
+	route         []RouteStop
 }
 
-func NewCourier(name string, speed int, location kernel.Location) (*Courier, error) {
+func NewCourier(name string, transport TransportType, speed int, location kernel.Location) (*Courier, error) {
 	if name == "" {
 		return nil, errs.NewValueIsRequiredError("name")
 	}
+	if transport.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("transport")
+	}
 	if speed <= 0 {
 		return nil, errs.NewValueIsRequiredError("speed")
 	}
+	if err := transport.ValidateSpeed(speed); err != nil {
+		return nil, err
+	}
+	return newCourier(name, transport, speed, location)
+}
+
+// NewCourierForSpeed creates the courier without an explicit transport the way couriers were
+// created before transport types: the transport is picked by speed, see TransportTypeForSpeed,
+// and a courier faster than the transport allows keeps the speed.
+func NewCourierForSpeed(name string, speed int, location kernel.Location) (*Courier, error) {
+	if name == "" {
+		return nil, errs.NewValueIsRequiredError("name")
+	}
+	if speed <= 0 {
+		return nil, errs.NewValueIsRequiredError("speed")
+	}
+	return newCourier(name, TransportTypeForSpeed(speed), speed, location)
+}
+
+func newCourier(name string, transport TransportType, speed int, location kernel.Location) (*Courier, error) {
 	if !location.IsValid() {
 		return nil, errs.NewValueIsRequiredError("location")
 	}
@@ -36,27 +67,45 @@ func NewCourier(name string, speed int, location kernel.Location) (*Courier, err
 	courier := &Courier{
 		baseAggregate: ddd.NewBaseAggregate(uuid.New()),
 		name:          name,
+		transport:     transport,
 		speed:         speed,
 		location:      location,
+		availability:  AvailabilityOnline,
 		storagePlaces: make([]*StoragePlace, 0),
 	}
 
-	// Добавляем дефолтное место хранения
-	err := courier.AddStoragePlace("Сумка", 10)
+	// Добавляем места хранения, положенные транспорту
+	places, err := transport.DefaultStoragePlaces()
 	if err != nil {
 		return nil, err
 	}
+	for _, place := range places {
+		courier.storagePlaces = append(courier.storagePlaces, place)
+		courier.RaiseDomainEvent(NewStoragePlaceAdded(courier.ID(), place))
+	}
 
 	return courier, nil
 }
 
-func RestoreCourier(id uuid.UUID, name string, speed int, location kernel.Location, places []*StoragePlace) *Courier {
+func RestoreCourier(
+	id uuid.UUID,
+	name string,
+	transport TransportType,
+	speed int,
+	location kernel.Location,
+	availability Availability,
+	places []*StoragePlace,
+	route []RouteStop,
+) *Courier {
 	return &Courier{
 		baseAggregate: ddd.NewBaseAggregate(id),
 		name:          name,
+		transport:     transport,
 		speed:         speed,
 		location:      location,
+		availability:  availability,
 		storagePlaces: places,
+		route:         route,
 	}
 }
 
@@ -87,6 +136,10 @@ func (c *Courier) Name() string {
 	return c.name
 }
 
+func (c *Courier) Transport() TransportType {
+	return c.transport
+}
+
 func (c *Courier) Speed() int {
 	return c.speed
 }
@@ -95,6 +148,51 @@ func (c *Courier) Location() kernel.Location {
 	return c.location
 }
 
+func (c *Courier) Availability() Availability {
+	if c == nil {
+		return AvailabilityEmpty
+	}
+	return c.availability
+}
+
+func (c *Courier) IsOnline() bool {
+	if c == nil {
+		return false
+	}
+	return c.availability == AvailabilityOnline
+}
+
+func (c *Courier) GoOnline() error {
+	return c.changeAvailability(AvailabilityOnline)
+}
+
+// GoOffline is allowed only with empty storage places: orders are not abandoned.
+func (c *Courier) GoOffline() error {
+	for _, storagePlace := range c.storagePlaces {
+		if storagePlace.IsOccupied() {
+			return ErrCourierIsCarryingOrder
+		}
+	}
+	return c.changeAvailability(AvailabilityOffline)
+}
+
+// StartBreak stops new assignments, the orders already taken stay with the courier.
+func (c *Courier) StartBreak() error {
+	if c.availability == AvailabilityOffline {
+		return errs.NewExpectationFailedError("availability", c.availability, AvailabilityOnline)
+	}
+	return c.changeAvailability(AvailabilityOnBreak)
+}
+
+func (c *Courier) changeAvailability(availability Availability) error {
+	if c.availability == availability {
+		return nil
+	}
+	c.RaiseDomainEvent(NewCourierAvailabilityChanged(c.ID(), c.availability, availability))
+	c.availability = availability
+	return nil
+}
+
 func (c *Courier) StoragePlaces() []StoragePlace {
 	res := make([]StoragePlace, len(c.storagePlaces))
 	for i, storagePlace := range c.storagePlaces {
@@ -103,22 +201,185 @@ func (c *Courier) StoragePlaces() []StoragePlace {
 	return res
 }
 
-func (c *Courier) AddStoragePlace(name string, volume int) error {
-	storagePlace, err := NewStoragePlace(name, volume)
+// OrderIDs returns the orders the courier is carrying right now.
+func (c *Courier) OrderIDs() []uuid.UUID {
+	res := make([]uuid.UUID, 0, len(c.storagePlaces))
+	for _, storagePlace := range c.storagePlaces {
+		res = append(res, storagePlace.OrderIDs()...)
+	}
+	return res
+}
+
+// Load is the volume taken by the carried orders.
+func (c *Courier) Load() int {
+	load := 0
+	for _, storagePlace := range c.storagePlaces {
+		load += storagePlace.OccupiedVolume()
+	}
+	return load
+}
+
+// Capacity is the total volume of the storage places, i.e. the maximum load.
+func (c *Courier) Capacity() int {
+	capacity := 0
+	for _, storagePlace := range c.storagePlaces {
+		capacity += storagePlace.TotalVolume()
+	}
+	return capacity
+}
+
+func (c *Courier) HasFreeStoragePlace() bool {
+	return c.Load() < c.Capacity()
+}
+
+// Route returns the planned stops in visiting order.
+func (c *Courier) Route() []RouteStop {
+	res := make([]RouteStop, len(c.route))
+	copy(res, c.route)
+	return res
+}
+
+// SetRoute replaces the planned route. Every carried order must be handed over exactly once
+// and may be collected once before that.
+func (c *Courier) SetRoute(route []RouteStop) error {
+	if !c.isRouteFor(route, c.OrderIDs()) {
+		return ErrRouteDoesNotMatchOrders
+	}
+
+	c.route = make([]RouteStop, len(route))
+	copy(c.route, route)
+	return nil
+}
+
+// IsRouteActual reports whether the planned route covers exactly the carried orders.
+func (c *Courier) IsRouteActual() bool {
+	return c.isRouteFor(c.route, c.OrderIDs())
+}
+
+// HasPickupStop reports whether the planned route still visits the pickup of the order.
+func (c *Courier) HasPickupStop(orderID uuid.UUID) bool {
+	for _, stop := range c.route {
+		if stop.OrderID() == orderID && stop.IsPickup() {
+			return true
+		}
+	}
+	return false
+}
+
+// NextStop returns the first stop of the planned route.
+func (c *Courier) NextStop() (RouteStop, bool) {
+	if len(c.route) == 0 {
+		return RouteStop{}, false
+	}
+	return c.route[0], true
+}
+
+// MoveAlongRoute makes one step towards the next stop of the planned route.
+func (c *Courier) MoveAlongRoute(area kernel.Area) error {
+	next, ok := c.NextStop()
+	if !ok {
+		return nil
+	}
+	return c.Move(next.Location(), area)
+}
+
+func (c *Courier) isRouteFor(route []RouteStop, orderIDs []uuid.UUID) bool {
+	pending := make(map[uuid.UUID]struct{}, len(orderIDs))
+	for _, orderID := range orderIDs {
+		pending[orderID] = struct{}{}
+	}
+
+	pickedUp := make(map[uuid.UUID]struct{}, len(orderIDs))
+	for _, stop := range route {
+		if !stop.IsValid() {
+			return false
+		}
+		if _, ok := pending[stop.OrderID()]; !ok {
+			return false
+		}
+		if stop.IsPickup() {
+			// Забрать заказ можно один раз и только до передачи клиенту
+			if _, ok := pickedUp[stop.OrderID()]; ok {
+				return false
+			}
+			pickedUp[stop.OrderID()] = struct{}{}
+			continue
+		}
+		delete(pending, stop.OrderID())
+	}
+	return len(pending) == 0
+}
+
+// dropRouteStops removes the stops of the order, of the given kind or all when kind is empty.
+func (c *Courier) dropRouteStops(orderID uuid.UUID, kind StopKind) {
+	route := c.route[:0:0]
+	for _, stop := range c.route {
+		if stop.OrderID() == orderID && (kind == StopKindEmpty || stop.Kind() == kind) {
+			continue
+		}
+		route = append(route, stop)
+	}
+	c.route = route
+}
+
+func (c *Courier) AddStoragePlace(name string, kind StoragePlaceKind, volume int, maxWeight int) error {
+	storagePlace, err := NewStoragePlace(name, kind, volume, maxWeight)
 	if err != nil {
 		return err
 	}
 	c.storagePlaces = append(c.storagePlaces, storagePlace)
+	c.RaiseDomainEvent(NewStoragePlaceAdded(c.ID(), storagePlace))
 	return nil
 }
 
+// RemoveStoragePlace takes the storage place away from the courier, an occupied place cannot be removed.
+func (c *Courier) RemoveStoragePlace(storagePlaceID uuid.UUID) error {
+	if storagePlaceID == uuid.Nil {
+		return errs.NewValueIsRequiredError("storagePlaceID")
+	}
+
+	i := slices.IndexFunc(c.storagePlaces, func(place *StoragePlace) bool { return place.ID() == storagePlaceID })
+	if i < 0 {
+		return errs.NewObjectNotFoundError("storagePlace", storagePlaceID)
+	}
+	if c.storagePlaces[i].IsOccupied() {
+		return ErrStoragePlaceIsOccupied
+	}
+
+	c.storagePlaces = slices.Delete(c.storagePlaces, i, i+1)
+	c.RaiseDomainEvent(NewStoragePlaceRemoved(c.ID(), storagePlaceID))
+	return nil
+}
+
+// MaxPayload is the total weight in grams the courier can carry, see TransportType.MaxPayload.
+func (c *Courier) MaxPayload() int {
+	return c.transport.MaxPayload()
+}
+
+// CarriedWeight is the weight in grams of the orders in all storage places.
+func (c *Courier) CarriedWeight() int {
+	carried := 0
+	for _, storagePlace := range c.storagePlaces {
+		carried += storagePlace.OccupiedWeight()
+	}
+	return carried
+}
+
 func (c *Courier) CanTakeOrder(order *order.Order) (bool, error) {
 	if order == nil {
 		return false, errs.NewValueIsRequiredError("order")
 	}
 
+	if !c.IsOnline() {
+		return false, nil
+	}
+
+	if c.CarriedWeight()+order.Weight() > c.MaxPayload() {
+		return false, nil
+	}
+
 	for _, storagePlace := range c.storagePlaces {
-		canStore, err := storagePlace.CanStore(order.Volume())
+		canStore, err := storagePlace.CanStore(order.Volume(), order.Weight(), order.Handling())
 		if err != nil {
 			return false, err
 		}
@@ -135,6 +396,10 @@ func (c *Courier) TakeOrder(order *order.Order) error {
 		return errs.NewValueIsRequiredError("order")
 	}
 
+	if !c.IsOnline() {
+		return ErrCourierIsNotAvailable
+	}
+
 	canTakeOrder, err := c.CanTakeOrder(order)
 	if err != nil {
 		return err
@@ -145,22 +410,41 @@ func (c *Courier) TakeOrder(order *order.Order) error {
 	}
 
 	for _, storagePlace := range c.storagePlaces {
-		canStore, err := storagePlace.CanStore(order.Volume())
+		canStore, err := storagePlace.CanStore(order.Volume(), order.Weight(), order.Handling())
 		if err != nil {
 			return err
 		}
 
 		if canStore {
-			err := storagePlace.Store(order.ID(), order.Volume())
+			err := storagePlace.Store(order.ID(), order.Volume(), order.Weight(), order.Handling())
 			if err != nil {
 				return err
 			}
+			c.RaiseDomainEvent(NewCourierTookOrder(c.ID(), order.ID(), storagePlace.ID()))
 			return nil
 		}
 	}
 	return ErrNoSuitableStoragePlace
 }
 
+// PickUpOrder marks the pickup stop of the carried order as visited.
+func (c *Courier) PickUpOrder(order *order.Order) error {
+	if order == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
+
+	storagePlace, err := c.findStoragePlaceByOrderID(order.ID())
+	if err != nil {
+		return err
+	}
+	if storagePlace == nil {
+		return errs.NewObjectNotFoundError("order", order.ID())
+	}
+
+	c.dropRouteStops(order.ID(), StopKindPickup)
+	return nil
+}
+
 func (c *Courier) CompleteOrder(order *order.Order) error {
 	if order == nil {
 		return errs.NewValueIsRequiredError("order")
@@ -175,14 +459,41 @@ func (c *Courier) CompleteOrder(order *order.Order) error {
 	if err != nil {
 		return err
 	}
+	c.dropRouteStops(order.ID(), StopKindEmpty)
+	c.RaiseDomainEvent(NewCourierCompletedOrder(c.ID(), order.ID(), storagePlace.ID()))
 	return nil
 }
 
-func (c *Courier) CalculateTimeToLocation(target kernel.Location) (float64, error) {
+// ReleaseOrder frees the storage place of an order which will not be delivered.
+func (c *Courier) ReleaseOrder(order *order.Order) error {
+	if order == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
+
+	storagePlace, err := c.findStoragePlaceByOrderID(order.ID())
+	if err != nil {
+		return err
+	}
+	if storagePlace == nil {
+		return errs.NewObjectNotFoundError("order", order.ID())
+	}
+
+	if err = storagePlace.Clear(order.ID()); err != nil {
+		return err
+	}
+	c.dropRouteStops(order.ID(), StopKindEmpty)
+	c.RaiseDomainEvent(NewCourierReleasedOrder(c.ID(), order.ID(), storagePlace.ID()))
+	return nil
+}
+
+func (c *Courier) CalculateTimeToLocation(target kernel.Location, area kernel.Area) (float64, error) {
 	if !target.IsValid() {
 		return 0, errs.NewValueIsRequiredError("target")
 	}
-	distance, err := c.location.DistanceTo(target)
+	if !area.IsValid() {
+		return 0, errs.NewValueIsRequiredError("area")
+	}
+	distance, err := area.Distance(c.location, target)
 	if err != nil {
 		return 0, err
 	}
@@ -191,10 +502,43 @@ func (c *Courier) CalculateTimeToLocation(target kernel.Location) (float64, erro
 	return time, err
 }
 
-func (c *Courier) Move(target kernel.Location) error {
+// CalculateTimeToDeliver estimates the steps to collect the order at its pickup
+// and bring it to the customer.
+func (c *Courier) CalculateTimeToDeliver(order *order.Order, area kernel.Area) (float64, error) {
+	if order == nil {
+		return 0, errs.NewValueIsRequiredError("order")
+	}
+	if !order.HasPickup() {
+		return c.CalculateTimeToLocation(order.Location(), area)
+	}
+	if !area.IsValid() {
+		return 0, errs.NewValueIsRequiredError("area")
+	}
+
+	toPickup, err := area.Distance(c.location, order.Pickup())
+	if err != nil {
+		return 0, err
+	}
+	toCustomer, err := area.Distance(order.Pickup(), order.Location())
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(toPickup+toCustomer) / float64(c.speed), nil
+}
+
+// Move makes one step towards the target. The courier may stand outside the area
+// after it shrinks, so the new location is not checked against the area bounds.
+func (c *Courier) Move(target kernel.Location, area kernel.Area) error {
 	if !target.IsValid() {
 		return errs.NewValueIsRequiredError("target")
 	}
+	if !area.IsValid() {
+		return errs.NewValueIsRequiredError("area")
+	}
+	if area.IsGeographic() && c.location.IsGeographic() && target.IsGeographic() {
+		return c.moveOnMap(target, area)
+	}
 
 	dx := float64(target.X() - c.location.X())
 	dy := float64(target.Y() - c.location.Y())
@@ -212,10 +556,39 @@ func (c *Courier) Move(target kernel.Location) error {
 	newX := c.location.X() + int(dx)
 	newY := c.location.Y() + int(dy)
 
-	newLocation, err := kernel.NewLocation(newX, newY)
+	newLocation := kernel.RestoreLocation(newX, newY)
+	if area.Contains(newLocation) {
+		located, err := area.NewLocation(newX, newY)
+		if err != nil {
+			return err
+		}
+		newLocation = located
+	}
+	if newLocation.Equals(c.location) {
+		return nil
+	}
+
+	c.RaiseDomainEvent(NewCourierMoved(c.ID(), c.location, newLocation))
+	c.location = newLocation
+	return nil
+}
+
+// moveOnMap moves the courier towards the target along the great circle by speed grid steps of the area.
+func (c *Courier) moveOnMap(target kernel.Location, area kernel.Area) error {
+	point, err := c.location.Point().MoveTowards(target.Point(), float64(c.speed)*area.UnitMeters())
 	if err != nil {
 		return err
 	}
+
+	newLocation := target
+	if !point.Equals(target.Point()) {
+		newLocation = area.Locate(point)
+	}
+	if newLocation.Equals(c.location) {
+		return nil
+	}
+
+	c.RaiseDomainEvent(NewCourierMoved(c.ID(), c.location, newLocation))
 	c.location = newLocation
 	return nil
 }
@@ -225,7 +598,7 @@ func (c *Courier) findStoragePlaceByOrderID(orderID uuid.UUID) (*StoragePlace, e
 		return nil, errs.NewValueIsRequiredError("orderID")
 	}
 	for _, storagePlace := range c.storagePlaces {
-		if storagePlace.OrderID() != nil && *storagePlace.OrderID() == orderID {
+		if storagePlace.Contains(orderID) {
 			return storagePlace, nil
 		}
 	}
@@ -17,44 +17,110 @@ func TestNewCourier(t *testing.T) {
 	assert := assert.New(t)
 
 	type args struct {
-		name     string
-		speed    int
-		location kernel.Location
+		name      string
+		transport TransportType
+		speed     int
+		location  kernel.Location
 	}
 	tests := []struct {
-		name string
-		args args
-		want error
+		name   string
+		args   args
+		places int
+		want   error
 	}{
 		{
 			name: "good",
+			args: args{
+				name:      "R2D2",
+				transport: TransportTypePedestrian,
+				speed:     1,
+				location:  kernel.NewRandomLocation(),
+			},
+			places: 1,
+			want:   nil,
+		},
+		{
+			name: "good car kit",
+			args: args{
+				name:      "KITT",
+				transport: TransportTypeCar,
+				speed:     3,
+				location:  kernel.NewRandomLocation(),
+			},
+			places: 2,
+			want:   nil,
+		},
+		{
+			name: "bad no transport",
 			args: args{
 				name:     "R2D2",
 				speed:    1,
 				location: kernel.NewRandomLocation(),
 			},
-			want: nil,
+			want: errs.ErrValueIsRequired,
+		},
+		{
+			name: "bad unknown transport",
+			args: args{
+				name:      "R2D2",
+				transport: TransportType("Rocket"),
+				speed:     1,
+				location:  kernel.NewRandomLocation(),
+			},
+			want: errs.ErrValueIsInvalid,
+		},
+		{
+			name: "bad too fast for transport",
+			args: args{
+				name:      "Flash",
+				transport: TransportTypePedestrian,
+				speed:     5,
+				location:  kernel.NewRandomLocation(),
+			},
+			want: errs.ErrValueIsOutOfRange,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewCourier(tt.args.name, tt.args.speed, tt.args.location)
+			got, err := NewCourier(tt.args.name, tt.args.transport, tt.args.speed, tt.args.location)
 			if err != nil {
 				assert.ErrorIs(err, tt.want)
-				assert.Empty(got.StoragePlaces())
-				assert.Empty(got.Name())
-				assert.Empty(got.Speed())
-				assert.Equal(kernel.Location{}, got.Location())
+				assert.Nil(got)
 			} else {
+				assert.NoError(tt.want)
 				assert.Equal(tt.args.name, got.Name())
+				assert.Equal(tt.args.transport, got.Transport())
 				assert.Equal(tt.args.speed, got.Speed())
 				assert.Equal(tt.args.location, got.Location())
-				assert.NotEmpty(got.StoragePlaces())
+				assert.Len(got.StoragePlaces(), tt.places)
+				assert.Len(got.GetDomainEvents(), tt.places)
 			}
 		})
 	}
 }
 
+func TestNewCourierForSpeed(t *testing.T) {
+	assert := assert.New(t)
+
+	walker, err := NewCourierForSpeed("R2D2", 1, kernel.NewRandomLocation())
+	assert.NoError(err)
+	assert.Equal(TransportTypePedestrian, walker.Transport())
+
+	// Быстрее машины курьер остается на машине со своей скоростью
+	racer, err := NewCourierForSpeed("Flash", 10, kernel.NewRandomLocation())
+	assert.NoError(err)
+	assert.Equal(TransportTypeCar, racer.Transport())
+	assert.Equal(10, racer.Speed())
+	assert.Len(racer.StoragePlaces(), 2)
+
+	_, err = NewCourierForSpeed("", 1, kernel.NewRandomLocation())
+	assert.ErrorIs(err, errs.ErrValueIsRequired)
+	_, err = NewCourierForSpeed("R2D2", 0, kernel.NewRandomLocation())
+	assert.ErrorIs(err, errs.ErrValueIsRequired)
+	_, err = NewCourierForSpeed("R2D2", 1, kernel.Location{})
+	assert.ErrorIs(err, errs.ErrValueIsRequired)
+}
+
 func TestCourier_AddStoragePlace(t *testing.T) {
 	assert := assert.New(t)
 
@@ -73,7 +139,7 @@ func TestCourier_AddStoragePlace(t *testing.T) {
 			name: "good",
 			args: args{name: "Pocket", volume: 1},
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return c
 			}(),
@@ -83,7 +149,7 @@ func TestCourier_AddStoragePlace(t *testing.T) {
 			name: "bad storage place",
 			args: args{volume: 1},
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return c
 			}(),
@@ -93,7 +159,7 @@ func TestCourier_AddStoragePlace(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.courier.AddStoragePlace(tt.args.name, tt.args.volume); err != nil {
+			if err := tt.courier.AddStoragePlace(tt.args.name, StoragePlaceKindAmbient, tt.args.volume, 0); err != nil {
 				assert.ErrorIs(err, tt.want)
 			} else {
 				assert.NotEmpty(tt.courier.StoragePlaces())
@@ -115,7 +181,7 @@ func TestCourier_CanTakeOrder(t *testing.T) {
 		{
 			name: "good",
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return c
 			}(),
@@ -130,7 +196,7 @@ func TestCourier_CanTakeOrder(t *testing.T) {
 		{
 			name: "good overweigth",
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return c
 			}(),
@@ -156,7 +222,7 @@ func TestCourier_CanTakeOrder(t *testing.T) {
 		{
 			name: "bad nil order",
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return c
 			}(),
@@ -166,7 +232,7 @@ func TestCourier_CanTakeOrder(t *testing.T) {
 		{
 			name: "bad order not initialized",
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return c
 			}(),
@@ -201,7 +267,7 @@ func TestCourier_TakeOrder(t *testing.T) {
 		{
 			name: "good",
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return c
 			}(),
@@ -215,7 +281,7 @@ func TestCourier_TakeOrder(t *testing.T) {
 		{
 			name: "good too big order",
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return c
 			}(),
@@ -236,9 +302,7 @@ func TestCourier_TakeOrder(t *testing.T) {
 				assert.Contains(func() []uuid.UUID {
 					var ids []uuid.UUID
 					for _, sp := range tt.courier.StoragePlaces() {
-						if sp.IsOccupied() {
-							ids = append(ids, *sp.OrderID())
-						}
+						ids = append(ids, sp.OrderIDs()...)
 					}
 					return ids
 				}(), tt.order.ID())
@@ -262,7 +326,7 @@ func TestCourier_CompleteOrder(t *testing.T) {
 		{
 			name: "good",
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				err = c.TakeOrder(one)
 				assert.NoError(err)
@@ -276,7 +340,7 @@ func TestCourier_CompleteOrder(t *testing.T) {
 		{
 			name: "good other order",
 			courier: func() *Courier {
-				c, err := NewCourier("test", 1, kernel.NewRandomLocation())
+				c, err := NewCourier("test", TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return c
 			}(),
@@ -297,9 +361,7 @@ func TestCourier_CompleteOrder(t *testing.T) {
 				assert.NotContains(func() []uuid.UUID {
 					var ids []uuid.UUID
 					for _, sp := range tt.courier.StoragePlaces() {
-						if sp.IsOccupied() {
-							ids = append(ids, *sp.OrderID())
-						}
+						ids = append(ids, sp.OrderIDs()...)
 					}
 					return ids
 				}(), tt.order.ID())
@@ -323,7 +385,7 @@ func TestCourier_CalculateTimeToLocation(t *testing.T) {
 			courier: func() *Courier {
 				loc, err := kernel.NewLocation(1, 1)
 				assert.NoError(err)
-				c, err := NewCourier("test", 2, loc)
+				c, err := NewCourier("test", TransportTypePedestrian, 2, loc)
 				assert.NoError(err)
 				return c
 			}(),
@@ -340,7 +402,7 @@ func TestCourier_CalculateTimeToLocation(t *testing.T) {
 			courier: func() *Courier {
 				loc, err := kernel.NewLocation(1, 1)
 				assert.NoError(err)
-				c, err := NewCourier("test", 2, loc)
+				c, err := NewCourier("test", TransportTypePedestrian, 2, loc)
 				assert.NoError(err)
 				return c
 			}(),
@@ -357,7 +419,7 @@ func TestCourier_CalculateTimeToLocation(t *testing.T) {
 			courier: func() *Courier {
 				loc, err := kernel.NewLocation(1, 1)
 				assert.NoError(err)
-				cur, err := NewCourier("test", 2, loc)
+				cur, err := NewCourier("test", TransportTypePedestrian, 2, loc)
 				assert.NoError(err)
 				return cur
 			}(),
@@ -368,7 +430,7 @@ func TestCourier_CalculateTimeToLocation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.courier.CalculateTimeToLocation(tt.target)
+			got, err := tt.courier.CalculateTimeToLocation(tt.target, kernel.DefaultArea())
 			if err != nil {
 				assert.ErrorIs(err, tt.wantErr)
 			} else {
@@ -392,7 +454,7 @@ func TestCourier_Move(t *testing.T) {
 			courier: func() *Courier {
 				loc, err := kernel.NewLocation(1, 1)
 				assert.NoError(err)
-				cur, err := NewCourier("test", 2, loc)
+				cur, err := NewCourier("test", TransportTypePedestrian, 2, loc)
 				assert.NoError(err)
 				return cur
 			}(),
@@ -408,7 +470,7 @@ func TestCourier_Move(t *testing.T) {
 			courier: func() *Courier {
 				loc, err := kernel.NewLocation(1, 1)
 				assert.NoError(err)
-				cur, err := NewCourier("test", 2, loc)
+				cur, err := NewCourier("test", TransportTypePedestrian, 2, loc)
 				assert.NoError(err)
 				return cur
 			}(),
@@ -424,7 +486,7 @@ func TestCourier_Move(t *testing.T) {
 				return
 			}
 
-			if err := tt.courier.Move(tt.target); err != nil {
+			if err := tt.courier.Move(tt.target, kernel.DefaultArea()); err != nil {
 				assert.ErrorIs(err, tt.want)
 			} else {
 				dt2, err := tt.courier.Location().DistanceTo(tt.target)
@@ -434,3 +496,390 @@ func TestCourier_Move(t *testing.T) {
 		})
 	}
 }
+
+func TestCourier_MoveOnMap(t *testing.T) {
+	assert := assert.New(t)
+	area, err := kernel.NewArea(1, 1, 10, 10)
+	assert.NoError(err)
+	bounds, err := kernel.NewGeoBounds(55.0, 37.0, 56.0, 38.0)
+	assert.NoError(err)
+	area, err = area.WithGeoBounds(bounds)
+	assert.NoError(err)
+
+	start, err := kernel.NewGeoPoint(55.05, 37.05)
+	assert.NoError(err)
+	finish, err := kernel.NewGeoPoint(55.83, 37.71)
+	assert.NoError(err)
+	from, err := area.NewGeoLocation(start)
+	assert.NoError(err)
+	to, err := area.NewGeoLocation(finish)
+	assert.NoError(err)
+
+	c, err := NewCourier("test", TransportTypeCar, 3, from)
+	assert.NoError(err)
+	c.ClearDomainEvents()
+
+	// Каждый такт курьер приближается к цели не более чем на speed шагов и остается в зоне
+	ticks := 0
+	for !c.Location().Equals(to) {
+		before, err := area.Distance(c.Location(), to)
+		assert.NoError(err)
+		assert.NoError(c.Move(to, area))
+		after, err := area.Distance(c.Location(), to)
+		assert.NoError(err)
+
+		assert.Less(after, before)
+		assert.LessOrEqual(before-after, c.Speed()+1)
+		assert.True(area.Contains(c.Location()))
+		ticks++
+		assert.LessOrEqual(ticks, 10)
+	}
+
+	// Оценка времени совпадает с фактическим числом тактов
+	estimate, err := area.Distance(kernel.RestoreGeoLocation(1, 1, start), to)
+	assert.NoError(err)
+	assert.Equal(int(math.Ceil(float64(estimate)/3)), ticks)
+
+	moved, ok := c.GetDomainEvents()[0].(CourierMoved)
+	assert.True(ok)
+	gotFrom, err := moved.From()
+	assert.NoError(err)
+	assert.Equal(from, gotFrom)
+}
+
+func TestCourier_MoveOutsideArea(t *testing.T) {
+	assert := assert.New(t)
+
+	// Зону сузили, а курьер остался за ее границей - он все равно доезжает до заказа
+	area, err := kernel.NewArea(1, 1, 5, 5)
+	assert.NoError(err)
+	target, err := area.NewLocation(5, 5)
+	assert.NoError(err)
+
+	c, err := NewCourier("test", TransportTypeBicycle, 2, kernel.RestoreLocation(9, 9))
+	assert.NoError(err)
+
+	assert.NoError(c.Move(target, area))
+	assert.Equal(kernel.RestoreLocation(7, 9), c.Location())
+	for i := 0; i < 3; i++ {
+		assert.NoError(c.Move(target, area))
+	}
+	assert.True(target.Equals(c.Location()))
+
+	assert.ErrorIs(c.Move(target, kernel.Area{}), errs.ErrValueIsRequired)
+}
+
+func TestCourier_DomainEvents(t *testing.T) {
+	assert := assert.New(t)
+
+	from, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	to, err := kernel.NewLocation(2, 1)
+	assert.NoError(err)
+
+	c, err := NewCourier("test", TransportTypePedestrian, 1, from)
+	assert.NoError(err)
+	assert.NoError(c.AddStoragePlace("Багажник", StoragePlaceKindAmbient, 100, 0))
+
+	o, err := order.NewOrder(uuid.New(), to, 1)
+	assert.NoError(err)
+	assert.NoError(c.TakeOrder(o))
+	assert.NoError(c.Move(to, kernel.DefaultArea()))
+	assert.NoError(c.Move(to, kernel.DefaultArea())) // уже на месте, событие не возникает
+	assert.NoError(c.CompleteOrder(o))
+
+	events := c.GetDomainEvents()
+	assert.Len(events, 5)
+
+	bag, ok := events[0].(StoragePlaceAdded)
+	assert.True(ok)
+	assert.Equal(c.ID(), bag.CourierID)
+	assert.Equal("Сумка", bag.Name)
+	assert.Equal(10, bag.TotalVolume)
+
+	trunk, ok := events[1].(StoragePlaceAdded)
+	assert.True(ok)
+	assert.Equal("Багажник", trunk.Name)
+
+	took, ok := events[2].(CourierTookOrder)
+	assert.True(ok)
+	assert.Equal(c.ID(), took.CourierID)
+	assert.Equal(o.ID(), took.OrderID)
+	assert.Equal(bag.StoragePlaceID, took.StoragePlaceID)
+
+	moved, ok := events[3].(CourierMoved)
+	assert.True(ok)
+	assert.Equal(c.ID(), moved.CourierID)
+	gotFrom, err := moved.From()
+	assert.NoError(err)
+	assert.Equal(from, gotFrom)
+	gotTo, err := moved.To()
+	assert.NoError(err)
+	assert.Equal(to, gotTo)
+
+	completed, ok := events[4].(CourierCompletedOrder)
+	assert.True(ok)
+	assert.Equal(c.ID(), completed.CourierID)
+	assert.Equal(o.ID(), completed.OrderID)
+	assert.Equal(bag.StoragePlaceID, completed.StoragePlaceID)
+}
+
+func TestCourier_Availability(t *testing.T) {
+	assert := assert.New(t)
+
+	location, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	target, err := kernel.NewLocation(5, 5)
+	assert.NoError(err)
+
+	courier, err := NewCourier("test", TransportTypePedestrian, 2, location)
+	assert.NoError(err)
+	assert.Equal(AvailabilityOnline, courier.Availability())
+	courier.ClearDomainEvents()
+
+	ordering, err := order.NewOrder(uuid.New(), target, 1)
+	assert.NoError(err)
+
+	// На перерыве новые заказы не берем
+	assert.NoError(courier.StartBreak())
+	assert.Equal(AvailabilityOnBreak, courier.Availability())
+	ok, err := courier.CanTakeOrder(ordering)
+	assert.NoError(err)
+	assert.False(ok)
+	assert.ErrorIs(courier.TakeOrder(ordering), ErrCourierIsNotAvailable)
+
+	// Повторный переход не порождает событий
+	assert.NoError(courier.StartBreak())
+	assert.Len(courier.GetDomainEvents(), 1)
+
+	assert.NoError(courier.GoOnline())
+	assert.NoError(courier.TakeOrder(ordering))
+
+	// С заказом уйти нельзя
+	assert.ErrorIs(courier.GoOffline(), ErrCourierIsCarryingOrder)
+	assert.NoError(courier.CompleteOrder(ordering))
+	assert.NoError(courier.GoOffline())
+	assert.False(courier.IsOnline())
+
+	// Из офлайна на перерыв не уходят
+	assert.ErrorIs(courier.StartBreak(), errs.ErrExpectationFailed)
+
+	event, ok := courier.GetDomainEvents()[0].(CourierAvailabilityChanged)
+	assert.True(ok)
+	assert.Equal(AvailabilityOnline, event.From)
+	assert.Equal(AvailabilityOnBreak, event.To)
+
+	var empty *Courier
+	assert.False(empty.IsOnline())
+	assert.Equal(AvailabilityEmpty, empty.Availability())
+}
+
+func TestCourier_Load(t *testing.T) {
+	assert := assert.New(t)
+
+	courier, err := NewCourier("test", TransportTypePedestrian, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+	assert.NoError(courier.AddStoragePlace("Багажник", StoragePlaceKindAmbient, 20, 0))
+	assert.Equal(0, courier.Load())
+	assert.Equal(30, courier.Capacity())
+
+	small, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	big, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 15)
+	assert.NoError(err)
+
+	// Заказ в сумке не мешает взять крупный заказ в багажник
+	assert.NoError(courier.TakeOrder(small))
+	assert.True(courier.HasFreeStoragePlace())
+	ok, err := courier.CanTakeOrder(big)
+	assert.NoError(err)
+	assert.True(ok)
+	assert.NoError(courier.TakeOrder(big))
+
+	assert.Equal(16, courier.Load())
+	assert.True(courier.HasFreeStoragePlace())
+	assert.ElementsMatch([]uuid.UUID{small.ID(), big.ID()}, courier.OrderIDs())
+}
+
+func TestCourier_CanTakeOrderByWeight(t *testing.T) {
+	assert := assert.New(t)
+
+	bicycle, err := NewCourier("test", TransportTypeBicycle, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+	assert.Equal(TransportTypeBicycle.MaxPayload(), bicycle.MaxPayload())
+
+	// Тяжелый заказ едет в багажнике, в сумку не помещается по весу
+	heavy, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(heavy.SetWeight(12000))
+	assert.NoError(bicycle.TakeOrder(heavy))
+	assert.Equal(12000, bicycle.CarriedWeight())
+	for _, place := range bicycle.StoragePlaces() {
+		assert.Equal(place.Name() == "Багажник", place.Contains(heavy.ID()))
+	}
+
+	// Объем свободен, но суммарная нагрузка превышает грузоподъемность
+	another, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(another.SetWeight(bicycle.MaxPayload() - 12000 + 1))
+	ok, err := bicycle.CanTakeOrder(another)
+	assert.NoError(err)
+	assert.False(ok)
+	assert.ErrorIs(bicycle.TakeOrder(another), ErrNoSuitableStoragePlace)
+
+	// Заказ без веса ограничивается только объемом
+	light, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	ok, err = bicycle.CanTakeOrder(light)
+	assert.NoError(err)
+	assert.True(ok)
+}
+
+func TestCourier_CanTakeOrderByHandling(t *testing.T) {
+	assert := assert.New(t)
+
+	// Багажник велосипеда не держит температуру, заказ едет в термосумке
+	bicycle, err := NewCourier("test", TransportTypeBicycle, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+	hot, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(hot.SetHandling(order.HandlingInsulated))
+	assert.NoError(bicycle.TakeOrder(hot))
+	for _, place := range bicycle.StoragePlaces() {
+		assert.Equal(place.Kind() == StoragePlaceKindInsulated, place.Contains(hot.ID()))
+	}
+
+	// Замороженный заказ некуда положить без холодильника
+	frozen, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(frozen.SetHandling(order.HandlingRefrigerated))
+	ok, err := bicycle.CanTakeOrder(frozen)
+	assert.NoError(err)
+	assert.False(ok)
+
+	assert.NoError(bicycle.AddStoragePlace("Холодильник", StoragePlaceKindRefrigerated, 10, 0))
+	ok, err = bicycle.CanTakeOrder(frozen)
+	assert.NoError(err)
+	assert.True(ok)
+}
+
+func TestCourier_RemoveStoragePlace(t *testing.T) {
+	assert := assert.New(t)
+
+	courier, err := NewCourier("test", TransportTypePedestrian, 2, kernel.NewRandomLocation())
+	assert.NoError(err)
+	assert.NoError(courier.AddStoragePlace("Багажник", StoragePlaceKindAmbient, 20, 0))
+
+	parcel, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(courier.TakeOrder(parcel))
+	bag, trunk := courier.StoragePlaces()[0], courier.StoragePlaces()[1]
+	assert.True(bag.Contains(parcel.ID()))
+
+	// Занятое место снять нельзя
+	assert.ErrorIs(courier.RemoveStoragePlace(bag.ID()), ErrStoragePlaceIsOccupied)
+	assert.ErrorIs(courier.RemoveStoragePlace(uuid.New()), errs.ErrObjectNotFound)
+	assert.ErrorIs(courier.RemoveStoragePlace(uuid.Nil), errs.ErrValueIsRequired)
+
+	courier.ClearDomainEvents()
+	assert.NoError(courier.RemoveStoragePlace(trunk.ID()))
+	assert.Len(courier.StoragePlaces(), 1)
+	removed, ok := courier.GetDomainEvents()[0].(StoragePlaceRemoved)
+	assert.True(ok)
+	assert.Equal(trunk.ID(), removed.StoragePlaceID)
+}
+
+func TestCourier_Route(t *testing.T) {
+	assert := assert.New(t)
+
+	start, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	near, err := kernel.NewLocation(1, 3)
+	assert.NoError(err)
+	far, err := kernel.NewLocation(5, 5)
+	assert.NoError(err)
+
+	courier, err := NewCourier("test", TransportTypePedestrian, 2, start)
+	assert.NoError(err)
+	assert.NoError(courier.AddStoragePlace("Багажник", StoragePlaceKindAmbient, 20, 0))
+	assert.True(courier.IsRouteActual())
+
+	first, err := order.NewOrder(uuid.New(), far, 1)
+	assert.NoError(err)
+	second, err := order.NewOrder(uuid.New(), near, 1)
+	assert.NoError(err)
+	assert.NoError(courier.TakeOrder(first))
+	assert.NoError(courier.TakeOrder(second))
+	assert.False(courier.IsRouteActual())
+
+	firstStop, err := NewRouteStop(first.ID(), first.Location())
+	assert.NoError(err)
+	secondStop, err := NewRouteStop(second.ID(), second.Location())
+	assert.NoError(err)
+
+	// Маршрут должен включать все заказы ровно по одному разу
+	assert.ErrorIs(courier.SetRoute([]RouteStop{secondStop}), ErrRouteDoesNotMatchOrders)
+	assert.ErrorIs(courier.SetRoute([]RouteStop{secondStop, secondStop}), ErrRouteDoesNotMatchOrders)
+	assert.NoError(courier.SetRoute([]RouteStop{secondStop, firstStop}))
+	assert.True(courier.IsRouteActual())
+
+	// Едем к первой точке маршрута
+	assert.NoError(courier.MoveAlongRoute(kernel.DefaultArea()))
+	assert.True(near.Equals(courier.Location()))
+
+	// Доставленный заказ уходит из маршрута
+	assert.NoError(courier.CompleteOrder(second))
+	assert.Equal([]RouteStop{firstStop}, courier.Route())
+	next, ok := courier.NextStop()
+	assert.True(ok)
+	assert.Equal(firstStop, next)
+	assert.True(courier.IsRouteActual())
+}
+
+func TestCourier_RouteWithPickup(t *testing.T) {
+	assert := assert.New(t)
+
+	start, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	warehouse, err := kernel.NewLocation(1, 2)
+	assert.NoError(err)
+	customer, err := kernel.NewLocation(3, 3)
+	assert.NoError(err)
+
+	courier, err := NewCourier("test", TransportTypePedestrian, 1, start)
+	assert.NoError(err)
+	o, err := order.NewOrder(uuid.New(), customer, 1)
+	assert.NoError(err)
+	assert.NoError(o.SetPickup(warehouse))
+	assert.NoError(courier.TakeOrder(o))
+
+	pickup, err := NewPickupStop(o.ID(), o.Pickup())
+	assert.NoError(err)
+	dropoff, err := NewRouteStop(o.ID(), o.Location())
+	assert.NoError(err)
+	assert.Equal(StopKindPickup, pickup.Kind())
+	assert.Equal(StopKindDropoff, dropoff.Kind())
+
+	// Забрать заказ можно только до передачи клиенту и только один раз
+	assert.ErrorIs(courier.SetRoute([]RouteStop{pickup}), ErrRouteDoesNotMatchOrders)
+	assert.ErrorIs(courier.SetRoute([]RouteStop{dropoff, pickup}), ErrRouteDoesNotMatchOrders)
+	assert.ErrorIs(courier.SetRoute([]RouteStop{pickup, pickup, dropoff}), ErrRouteDoesNotMatchOrders)
+	assert.NoError(courier.SetRoute([]RouteStop{pickup, dropoff}))
+	assert.True(courier.HasPickupStop(o.ID()))
+
+	// Оценка учитывает оба отрезка: 1 шаг до склада и 3 до клиента
+	dt, err := courier.CalculateTimeToDeliver(o, kernel.DefaultArea())
+	assert.NoError(err)
+	assert.Equal(4.0, dt)
+
+	assert.NoError(courier.MoveAlongRoute(kernel.DefaultArea()))
+	assert.True(warehouse.Equals(courier.Location()))
+	assert.NoError(courier.PickUpOrder(o))
+	assert.False(courier.HasPickupStop(o.ID()))
+	assert.Equal([]RouteStop{dropoff}, courier.Route())
+	assert.True(courier.IsRouteActual())
+
+	other, err := order.NewOrder(uuid.New(), customer, 1)
+	assert.NoError(err)
+	assert.ErrorIs(courier.PickUpOrder(other), errs.ErrObjectNotFound)
+}
@@ -0,0 +1,196 @@
+package courier
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventNameCourierMoved          = "courier.moved"
+	EventNameCourierTookOrder      = "courier.took_order"
+	EventNameCourierCompletedOrder = "courier.completed_order"
+	EventNameCourierReleasedOrder  = "courier.released_order"
+	EventNameStoragePlaceAdded     = "courier.storage_place_added"
+	EventNameStoragePlaceRemoved   = "courier.storage_place_removed"
+	EventNameAvailabilityChanged   = "courier.availability_changed"
+)
+
+var (
+	_ ddd.DomainEvent = CourierMoved{}
+	_ ddd.DomainEvent = CourierTookOrder{}
+	_ ddd.DomainEvent = CourierCompletedOrder{}
+	_ ddd.DomainEvent = CourierReleasedOrder{}
+	_ ddd.DomainEvent = StoragePlaceAdded{}
+	_ ddd.DomainEvent = StoragePlaceRemoved{}
+	_ ddd.DomainEvent = CourierAvailabilityChanged{}
+)
+
+// CourierMoved keeps coordinates as plain numbers so the event stays serializable.
+// Lat/Lon fields are set only for geographic locations.
+type CourierMoved struct {
+	ID        uuid.UUID
+	CourierID uuid.UUID
+	FromX     int
+	FromY     int
+	ToX       int
+	ToY       int
+	FromLat   *float64 `json:",omitempty"`
+	FromLon   *float64 `json:",omitempty"`
+	ToLat     *float64 `json:",omitempty"`
+	ToLon     *float64 `json:",omitempty"`
+}
+
+func NewCourierMoved(courierID uuid.UUID, from, to kernel.Location) CourierMoved {
+	event := CourierMoved{
+		ID:        uuid.New(),
+		CourierID: courierID,
+		FromX:     from.X(),
+		FromY:     from.Y(),
+		ToX:       to.X(),
+		ToY:       to.Y(),
+	}
+	if from.IsGeographic() {
+		lat, lon := from.Point().Lat(), from.Point().Lon()
+		event.FromLat, event.FromLon = &lat, &lon
+	}
+	if to.IsGeographic() {
+		lat, lon := to.Point().Lat(), to.Point().Lon()
+		event.ToLat, event.ToLon = &lat, &lon
+	}
+	return event
+}
+
+func (e CourierMoved) GetID() uuid.UUID { return e.ID }
+func (e CourierMoved) GetName() string  { return EventNameCourierMoved }
+
+func (e CourierMoved) From() (kernel.Location, error) {
+	return movedLocation(e.FromX, e.FromY, e.FromLat, e.FromLon)
+}
+
+func (e CourierMoved) To() (kernel.Location, error) {
+	return movedLocation(e.ToX, e.ToY, e.ToLat, e.ToLon)
+}
+
+func movedLocation(x, y int, lat, lon *float64) (kernel.Location, error) {
+	if lat == nil || lon == nil {
+		return kernel.RestoreLocation(x, y), nil
+	}
+
+	point, err := kernel.NewGeoPoint(*lat, *lon)
+	if err != nil {
+		return kernel.Location{}, err
+	}
+	return kernel.RestoreGeoLocation(x, y, point), nil
+}
+
+type CourierTookOrder struct {
+	ID             uuid.UUID
+	CourierID      uuid.UUID
+	OrderID        uuid.UUID
+	StoragePlaceID uuid.UUID
+}
+
+func NewCourierTookOrder(courierID, orderID, storagePlaceID uuid.UUID) CourierTookOrder {
+	return CourierTookOrder{
+		ID:             uuid.New(),
+		CourierID:      courierID,
+		OrderID:        orderID,
+		StoragePlaceID: storagePlaceID,
+	}
+}
+
+func (e CourierTookOrder) GetID() uuid.UUID { return e.ID }
+func (e CourierTookOrder) GetName() string  { return EventNameCourierTookOrder }
+
+type CourierCompletedOrder struct {
+	ID             uuid.UUID
+	CourierID      uuid.UUID
+	OrderID        uuid.UUID
+	StoragePlaceID uuid.UUID
+}
+
+func NewCourierCompletedOrder(courierID, orderID, storagePlaceID uuid.UUID) CourierCompletedOrder {
+	return CourierCompletedOrder{
+		ID:             uuid.New(),
+		CourierID:      courierID,
+		OrderID:        orderID,
+		StoragePlaceID: storagePlaceID,
+	}
+}
+
+func (e CourierCompletedOrder) GetID() uuid.UUID { return e.ID }
+func (e CourierCompletedOrder) GetName() string  { return EventNameCourierCompletedOrder }
+
+type CourierReleasedOrder struct {
+	ID             uuid.UUID
+	CourierID      uuid.UUID
+	OrderID        uuid.UUID
+	StoragePlaceID uuid.UUID
+}
+
+func NewCourierReleasedOrder(courierID, orderID, storagePlaceID uuid.UUID) CourierReleasedOrder {
+	return CourierReleasedOrder{
+		ID:             uuid.New(),
+		CourierID:      courierID,
+		OrderID:        orderID,
+		StoragePlaceID: storagePlaceID,
+	}
+}
+
+func (e CourierReleasedOrder) GetID() uuid.UUID { return e.ID }
+func (e CourierReleasedOrder) GetName() string  { return EventNameCourierReleasedOrder }
+
+type StoragePlaceAdded struct {
+	ID             uuid.UUID
+	CourierID      uuid.UUID
+	StoragePlaceID uuid.UUID
+	Name           string
+	TotalVolume    int
+}
+
+func NewStoragePlaceAdded(courierID uuid.UUID, storagePlace *StoragePlace) StoragePlaceAdded {
+	return StoragePlaceAdded{
+		ID:             uuid.New(),
+		CourierID:      courierID,
+		StoragePlaceID: storagePlace.ID(),
+		Name:           storagePlace.Name(),
+		TotalVolume:    storagePlace.TotalVolume(),
+	}
+}
+
+func (e StoragePlaceAdded) GetID() uuid.UUID { return e.ID }
+func (e StoragePlaceAdded) GetName() string  { return EventNameStoragePlaceAdded }
+
+type StoragePlaceRemoved struct {
+	ID             uuid.UUID
+	CourierID      uuid.UUID
+	StoragePlaceID uuid.UUID
+}
+
+func NewStoragePlaceRemoved(courierID, storagePlaceID uuid.UUID) StoragePlaceRemoved {
+	return StoragePlaceRemoved{ID: uuid.New(), CourierID: courierID, StoragePlaceID: storagePlaceID}
+}
+
+func (e StoragePlaceRemoved) GetID() uuid.UUID { return e.ID }
+func (e StoragePlaceRemoved) GetName() string  { return EventNameStoragePlaceRemoved }
+
+type CourierAvailabilityChanged struct {
+	ID        uuid.UUID
+	CourierID uuid.UUID
+	From      Availability
+	To        Availability
+}
+
+func NewCourierAvailabilityChanged(courierID uuid.UUID, from, to Availability) CourierAvailabilityChanged {
+	return CourierAvailabilityChanged{
+		ID:        uuid.New(),
+		CourierID: courierID,
+		From:      from,
+		To:        to,
+	}
+}
+
+func (e CourierAvailabilityChanged) GetID() uuid.UUID { return e.ID }
+func (e CourierAvailabilityChanged) GetName() string  { return EventNameAvailabilityChanged }
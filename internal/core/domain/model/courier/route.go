@@ -0,0 +1,70 @@
+package courier
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+var ErrRouteDoesNotMatchOrders = errors.New("route does not match carried orders")
+
+// StopKind tells what the courier does at the route stop.
+type StopKind string
+
+const (
+	StopKindEmpty   StopKind = ""
+	StopKindPickup  StopKind = "Pickup"
+	StopKindDropoff StopKind = "Dropoff"
+)
+
+func (k StopKind) IsValid() bool { return k == StopKindPickup || k == StopKindDropoff }
+
+func (k StopKind) String() string { return string(k) }
+
+// RouteStop is a point of the planned route where the order is collected or handed over.
+type RouteStop struct {
+	orderID  uuid.UUID
+	kind     StopKind
+	location kernel.Location
+}
+
+// NewRouteStop makes the stop where the order is handed over to the customer.
+func NewRouteStop(orderID uuid.UUID, location kernel.Location) (RouteStop, error) {
+	return NewRouteStopOfKind(orderID, StopKindDropoff, location)
+}
+
+// NewPickupStop makes the stop where the order is collected from the merchant.
+func NewPickupStop(orderID uuid.UUID, location kernel.Location) (RouteStop, error) {
+	return NewRouteStopOfKind(orderID, StopKindPickup, location)
+}
+
+func NewRouteStopOfKind(orderID uuid.UUID, kind StopKind, location kernel.Location) (RouteStop, error) {
+	if orderID == uuid.Nil {
+		return RouteStop{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if !kind.IsValid() {
+		return RouteStop{}, errs.NewValueIsInvalidError("kind")
+	}
+
+	if !location.IsValid() {
+		return RouteStop{}, errs.NewValueIsRequiredError("location")
+	}
+
+	return RouteStop{orderID: orderID, kind: kind, location: location}, nil
+}
+
+func (s RouteStop) OrderID() uuid.UUID { return s.orderID }
+
+func (s RouteStop) Kind() StopKind { return s.kind }
+
+func (s RouteStop) IsPickup() bool { return s.kind == StopKindPickup }
+
+func (s RouteStop) Location() kernel.Location { return s.location }
+
+func (s RouteStop) IsValid() bool {
+	return s.orderID != uuid.Nil && s.kind.IsValid() && s.location.IsValid()
+}
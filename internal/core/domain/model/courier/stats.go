@@ -0,0 +1,95 @@
+package courier
+
+import (
+	"time"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+// ValidateRating checks the score a customer gives for a delivery.
+func ValidateRating(score int) error {
+	if score < MinRating || score > MaxRating {
+		return errs.NewValueIsOutOfRangeError("rating", score, MinRating, MaxRating)
+	}
+	return nil
+}
+
+// Stats is the accumulated performance of the courier: delivered orders, travelled
+// distance in grid steps of the delivery area, total delivery time and customer ratings.
+// Only timedDeliveries, the orders with a known creation time, make up deliveryTime.
+type Stats struct {
+	courierID       uuid.UUID
+	deliveredOrders int
+	distance        int
+	deliveryTime    time.Duration
+	timedDeliveries int
+	ratingSum       int
+	ratingCount     int
+}
+
+func RestoreStats(
+	courierID uuid.UUID,
+	deliveredOrders int,
+	distance int,
+	deliveryTime time.Duration,
+	timedDeliveries int,
+	ratingSum int,
+	ratingCount int,
+) Stats {
+	return Stats{
+		courierID:       courierID,
+		deliveredOrders: deliveredOrders,
+		distance:        distance,
+		deliveryTime:    deliveryTime,
+		timedDeliveries: timedDeliveries,
+		ratingSum:       ratingSum,
+		ratingCount:     ratingCount,
+	}
+}
+
+func (s Stats) CourierID() uuid.UUID { return s.courierID }
+
+func (s Stats) DeliveredOrders() int { return s.deliveredOrders }
+
+func (s Stats) Distance() int { return s.distance }
+
+func (s Stats) RatingCount() int { return s.ratingCount }
+
+// AverageDeliveryTime returns zero until the courier delivers an order with a known delivery time.
+func (s Stats) AverageDeliveryTime() time.Duration {
+	if s.timedDeliveries == 0 {
+		return 0
+	}
+	return s.deliveryTime / time.Duration(s.timedDeliveries)
+}
+
+// Rating returns the average customer score, false when nobody has rated the courier yet.
+func (s Stats) Rating() (float64, bool) {
+	if s.ratingCount == 0 {
+		return 0, false
+	}
+	return float64(s.ratingSum) / float64(s.ratingCount), true
+}
+
+// IsBetterThan compares couriers which are equally good for an order otherwise.
+// A higher rating wins, then a shorter average delivery time, then more delivered orders.
+func (s Stats) IsBetterThan(other Stats) bool {
+	rating, rated := s.Rating()
+	otherRating, otherRated := other.Rating()
+	if rated && otherRated && rating != otherRating {
+		return rating > otherRating
+	}
+
+	if s.timedDeliveries > 0 && other.timedDeliveries > 0 && s.AverageDeliveryTime() != other.AverageDeliveryTime() {
+		return s.AverageDeliveryTime() < other.AverageDeliveryTime()
+	}
+
+	return s.deliveredOrders > other.deliveredOrders
+}
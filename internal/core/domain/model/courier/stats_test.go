@@ -0,0 +1,91 @@
+package courier_test
+
+import (
+	"testing"
+	"time"
+
+	. "delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRating(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(ValidateRating(MinRating))
+	assert.NoError(ValidateRating(MaxRating))
+	assert.ErrorIs(ValidateRating(0), errs.ErrValueIsOutOfRange)
+	assert.ErrorIs(ValidateRating(6), errs.ErrValueIsOutOfRange)
+}
+
+func TestStats(t *testing.T) {
+	assert := assert.New(t)
+
+	// Пустая статистика
+	empty := RestoreStats(uuid.New(), 0, 0, 0, 0, 0, 0)
+	assert.Zero(empty.AverageDeliveryTime())
+	_, rated := empty.Rating()
+	assert.False(rated)
+
+	stats := RestoreStats(uuid.New(), 4, 120, 40*time.Minute, 4, 9, 2)
+	assert.Equal(4, stats.DeliveredOrders())
+	assert.Equal(120, stats.Distance())
+	assert.Equal(10*time.Minute, stats.AverageDeliveryTime())
+	rating, rated := stats.Rating()
+	assert.True(rated)
+	assert.Equal(4.5, rating)
+	assert.Equal(2, stats.RatingCount())
+
+	// Доставки с неизвестным временем не занижают среднее
+	legacy := RestoreStats(uuid.New(), 6, 0, 40*time.Minute, 4, 0, 0)
+	assert.Equal(6, legacy.DeliveredOrders())
+	assert.Equal(10*time.Minute, legacy.AverageDeliveryTime())
+}
+
+func TestStats_IsBetterThan(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats Stats
+		other Stats
+		want  bool
+	}{
+		{
+			name:  "higher rating",
+			stats: RestoreStats(uuid.New(), 1, 0, time.Hour, 1, 5, 1),
+			other: RestoreStats(uuid.New(), 9, 0, time.Minute, 9, 4, 1),
+			want:  true,
+		},
+		{
+			name:  "faster on equal rating",
+			stats: RestoreStats(uuid.New(), 2, 0, 20*time.Minute, 2, 5, 1),
+			other: RestoreStats(uuid.New(), 2, 0, 40*time.Minute, 2, 5, 1),
+			want:  true,
+		},
+		{
+			name:  "unrated compared by delivery time",
+			stats: RestoreStats(uuid.New(), 2, 0, 40*time.Minute, 2, 0, 0),
+			other: RestoreStats(uuid.New(), 2, 0, 20*time.Minute, 2, 5, 1),
+			want:  false,
+		},
+		{
+			name:  "more delivered orders",
+			stats: RestoreStats(uuid.New(), 3, 0, 0, 0, 0, 0),
+			other: RestoreStats(uuid.New(), 0, 0, 0, 0, 0, 0),
+			want:  true,
+		},
+		{
+			name:  "equal",
+			stats: RestoreStats(uuid.New(), 0, 0, 0, 0, 0, 0),
+			other: RestoreStats(uuid.New(), 0, 0, 0, 0, 0, 0),
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.stats.IsBetterThan(tt.other))
+		})
+	}
+}
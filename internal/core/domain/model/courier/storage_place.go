@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
@@ -14,39 +15,82 @@ var (
 	ErrStoragePlaceIsOccupied     = errors.New("storage place is occupied")
 	ErrTotalVolumeMustPositive    = errors.New("totalVolume should be greater than 0")
 	ErrVolumeMustPositive         = errors.New("volume should be greater than 0")
+	ErrWeightMustNotNegative      = errors.New("weight should not be negative")
+	ErrNotEnoughFreeVolume        = errors.New("not enough free volume")
+	ErrNotEnoughFreeWeight        = errors.New("not enough free weight")
+	ErrOrderAlreadyStored         = errors.New("order already stored")
+	ErrStoragePlaceKindMismatch   = errors.New("storage place kind does not suit the order")
 )
 
+// StoredOrder is an order put into a storage place together with the volume and weight it takes.
+type StoredOrder struct {
+	orderID uuid.UUID
+	volume  int
+	weight  int
+}
+
+func RestoreStoredOrder(orderID uuid.UUID, volume, weight int) StoredOrder {
+	return StoredOrder{orderID: orderID, volume: volume, weight: weight}
+}
+
+func (o StoredOrder) OrderID() uuid.UUID { return o.orderID }
+
+func (o StoredOrder) Volume() int { return o.volume }
+
+// Weight is in grams, zero for orders stored without a known weight.
+func (o StoredOrder) Weight() int { return o.weight }
+
 type StoragePlace struct {
 	id          uuid.UUID
 	name        string
+	kind        StoragePlaceKind
 	totalVolume int
-	orderID     *uuid.UUID
+	// maxWeight - допустимая нагрузка в граммах, 0 - без ограничения
+	maxWeight int
+	orders    []StoredOrder
 }
 
-func NewStoragePlace(name string, totalVolume int) (*StoragePlace, error) {
+// NewStoragePlace makes an empty storage place, maxWeight is in grams and zero means no limit.
+func NewStoragePlace(name string, kind StoragePlaceKind, totalVolume int, maxWeight int) (*StoragePlace, error) {
 	name = strings.TrimSpace(name)
 	if name == "" {
 		return nil, errs.NewValueIsRequiredError("name")
 	}
 
+	if kind.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("kind")
+	}
+
+	if !kind.IsValid() {
+		return nil, errs.NewValueIsInvalidError("kind")
+	}
+
 	if totalVolume <= 0 {
 		return nil, errs.NewValueIsInvalidErrorWithCause("totalVolume", ErrTotalVolumeMustPositive)
 	}
 
-	return &StoragePlace{id: uuid.New(), name: name, totalVolume: totalVolume}, nil
-}
-
-func RestoreStoragePlace(id uuid.UUID, name string, totalVolume int, orderID uuid.UUID) *StoragePlace {
-	oid := &orderID
-	if orderID == uuid.Nil {
-		oid = nil
+	if maxWeight < 0 {
+		return nil, errs.NewValueIsInvalidErrorWithCause("maxWeight", ErrWeightMustNotNegative)
 	}
 
+	return &StoragePlace{id: uuid.New(), name: name, kind: kind, totalVolume: totalVolume, maxWeight: maxWeight}, nil
+}
+
+func RestoreStoragePlace(
+	id uuid.UUID,
+	name string,
+	kind StoragePlaceKind,
+	totalVolume int,
+	maxWeight int,
+	orders []StoredOrder,
+) *StoragePlace {
 	return &StoragePlace{
 		id:          id,
 		name:        name,
+		kind:        kind,
 		totalVolume: totalVolume,
-		orderID:     oid,
+		maxWeight:   maxWeight,
+		orders:      orders,
 	}
 }
 
@@ -56,7 +100,7 @@ func NewBag() *StoragePlace {
 		volume = 10
 	)
 
-	bag, err := NewStoragePlace(name, volume)
+	bag, err := NewStoragePlace(name, StoragePlaceKindAmbient, volume, 0)
 	if err != nil {
 		panic(err)
 	}
@@ -88,6 +132,14 @@ func (sp *StoragePlace) Name() string {
 	return sp.name
 }
 
+func (sp *StoragePlace) Kind() StoragePlaceKind {
+	if sp == nil {
+		return StoragePlaceKindEmpty
+	}
+
+	return sp.kind
+}
+
 func (sp *StoragePlace) TotalVolume() int {
 	if sp == nil {
 		return 0
@@ -96,31 +148,107 @@ func (sp *StoragePlace) TotalVolume() int {
 	return sp.totalVolume
 }
 
-func (sp *StoragePlace) OrderID() *uuid.UUID {
+// MaxWeight is the payload limit in grams, zero when the place is not limited by weight.
+func (sp *StoragePlace) MaxWeight() int {
+	if sp == nil {
+		return 0
+	}
+
+	return sp.maxWeight
+}
+
+// Orders returns the stored orders in the order they were put in.
+func (sp *StoragePlace) Orders() []StoredOrder {
+	if sp == nil {
+		return nil
+	}
+
+	res := make([]StoredOrder, len(sp.orders))
+	copy(res, sp.orders)
+	return res
+}
+
+func (sp *StoragePlace) OrderIDs() []uuid.UUID {
 	if sp == nil {
 		return nil
 	}
 
-	return sp.orderID
+	res := make([]uuid.UUID, 0, len(sp.orders))
+	for _, stored := range sp.orders {
+		res = append(res, stored.orderID)
+	}
+	return res
+}
+
+func (sp *StoragePlace) Contains(orderID uuid.UUID) bool {
+	return sp.indexOf(orderID) >= 0
+}
+
+func (sp *StoragePlace) OccupiedVolume() int {
+	if sp == nil {
+		return 0
+	}
+
+	occupied := 0
+	for _, stored := range sp.orders {
+		occupied += stored.volume
+	}
+	return occupied
+}
+
+func (sp *StoragePlace) FreeVolume() int {
+	if sp == nil {
+		return 0
+	}
+
+	return sp.totalVolume - sp.OccupiedVolume()
+}
+
+func (sp *StoragePlace) OccupiedWeight() int {
+	if sp == nil {
+		return 0
+	}
+
+	occupied := 0
+	for _, stored := range sp.orders {
+		occupied += stored.weight
+	}
+	return occupied
+}
+
+func (sp *StoragePlace) hasFreeWeight(weight int) bool {
+	return sp.maxWeight == 0 || sp.maxWeight-sp.OccupiedWeight() >= weight
 }
 
-func (sp *StoragePlace) CanStore(volume int) (bool, error) {
+func (sp *StoragePlace) CanStore(volume int, weight int, handling order.Handling) (bool, error) {
 	if sp == nil {
 		return false, ErrStoragePlaceNotInitialized
 	}
 
+	if !handling.IsValid() {
+		return false, errs.NewValueIsInvalidError("handling")
+	}
+
 	if volume <= 0 {
 		return false, errs.NewValueIsInvalidErrorWithCause("volume", ErrVolumeMustPositive)
 	}
 
-	return !sp.IsOccupied() && sp.totalVolume >= volume, nil
+	if weight < 0 {
+		return false, errs.NewValueIsInvalidErrorWithCause("weight", ErrWeightMustNotNegative)
+	}
+
+	return sp.kind.Keeps(handling) && sp.FreeVolume() >= volume && sp.hasFreeWeight(weight), nil
 }
 
-func (sp *StoragePlace) Store(orderID uuid.UUID, volume int) error {
+func (sp *StoragePlace) Store(orderID uuid.UUID, volume int, weight int, handling order.Handling) error {
 	if sp == nil {
 		return ErrStoragePlaceNotInitialized
 	}
 
+	if !handling.IsValid() {
+		return errs.NewValueIsInvalidError("handling")
+	}
+
 	if orderID == uuid.Nil {
 		return errs.NewValueIsInvalidError("orderID")
 	}
@@ -129,15 +257,35 @@ func (sp *StoragePlace) Store(orderID uuid.UUID, volume int) error {
 		return errs.NewValueIsInvalidErrorWithCause("volume", ErrVolumeMustPositive)
 	}
 
-	if sp.IsOccupied() {
-		return ErrStoragePlaceIsOccupied
+	if weight < 0 {
+		return errs.NewValueIsInvalidErrorWithCause("weight", ErrWeightMustNotNegative)
 	}
 
 	if sp.totalVolume < volume {
 		return errs.NewValueIsOutOfRangeError("volume", volume, 1, sp.totalVolume)
 	}
 
-	sp.orderID = &orderID
+	if sp.maxWeight > 0 && sp.maxWeight < weight {
+		return errs.NewValueIsOutOfRangeError("weight", weight, 0, sp.maxWeight)
+	}
+
+	if sp.Contains(orderID) {
+		return ErrOrderAlreadyStored
+	}
+
+	if !sp.kind.Keeps(handling) {
+		return ErrStoragePlaceKindMismatch
+	}
+
+	if sp.FreeVolume() < volume {
+		return ErrNotEnoughFreeVolume
+	}
+
+	if !sp.hasFreeWeight(weight) {
+		return ErrNotEnoughFreeWeight
+	}
+
+	sp.orders = append(sp.orders, StoredOrder{orderID: orderID, volume: volume, weight: weight})
 	return nil
 }
 
@@ -146,11 +294,12 @@ func (sp *StoragePlace) Clear(orderID uuid.UUID) error {
 		return ErrStoragePlaceNotInitialized
 	}
 
-	if sp.orderID == nil || *sp.orderID != orderID {
+	i := sp.indexOf(orderID)
+	if i < 0 {
 		return errs.NewObjectNotFoundError("orderID", orderID)
 	}
 
-	sp.orderID = nil
+	sp.orders = append(sp.orders[:i:i], sp.orders[i+1:]...)
 	return nil
 }
 
@@ -159,5 +308,18 @@ func (sp *StoragePlace) IsOccupied() bool {
 		return false
 	}
 
-	return sp.orderID != nil
+	return len(sp.orders) > 0
+}
+
+func (sp *StoragePlace) indexOf(orderID uuid.UUID) int {
+	if sp == nil {
+		return -1
+	}
+
+	for i, stored := range sp.orders {
+		if stored.orderID == orderID {
+			return i
+		}
+	}
+	return -1
 }
@@ -0,0 +1,45 @@
+package courier
+
+import (
+	"delivery/internal/core/domain/model/order"
+)
+
+const (
+	StoragePlaceKindEmpty        StoragePlaceKind = ""
+	StoragePlaceKindAmbient      StoragePlaceKind = "Ambient"
+	StoragePlaceKindInsulated    StoragePlaceKind = "Insulated"
+	StoragePlaceKindRefrigerated StoragePlaceKind = "Refrigerated"
+)
+
+// StoragePlaceKind is the temperature control the storage place provides.
+type StoragePlaceKind string
+
+func (k StoragePlaceKind) Equals(other StoragePlaceKind) bool {
+	return k == other
+}
+
+func (k StoragePlaceKind) IsEmpty() bool {
+	return k == StoragePlaceKindEmpty
+}
+
+func (k StoragePlaceKind) IsValid() bool {
+	return k == StoragePlaceKindAmbient || k == StoragePlaceKindInsulated || k == StoragePlaceKindRefrigerated
+}
+
+func (k StoragePlaceKind) String() string {
+	return string(k)
+}
+
+// Keeps reports whether the order with the handling may travel in this kind of storage place.
+// A refrigerated place also keeps the temperature of insulated orders, any place suits ambient ones.
+func (k StoragePlaceKind) Keeps(handling order.Handling) bool {
+	switch handling {
+	case order.HandlingAmbient:
+		return k.IsValid()
+	case order.HandlingInsulated:
+		return k == StoragePlaceKindInsulated || k == StoragePlaceKindRefrigerated
+	case order.HandlingRefrigerated:
+		return k == StoragePlaceKindRefrigerated
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	. "delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
@@ -26,12 +27,12 @@ func TestNewStoragePlace(t *testing.T) {
 	assert := assert.New(t)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			sp, err := NewStoragePlace(tt.place, tt.totalVolume)
+			sp, err := NewStoragePlace(tt.place, StoragePlaceKindAmbient, tt.totalVolume, 0)
 			if err != nil {
 				assert.ErrorIs(err, tt.want)
 			}
 
-			assert.Empty(sp.OrderID())
+			assert.Empty(sp.OrderIDs())
 
 			if err != nil {
 				assert.Empty(sp.ID())
@@ -48,6 +49,7 @@ func TestNewStoragePlace(t *testing.T) {
 }
 
 func TestStoragePlace_Store(t *testing.T) {
+	id := uuid.New()
 	tests := []struct {
 		name    string
 		place   *StoragePlace
@@ -57,7 +59,7 @@ func TestStoragePlace_Store(t *testing.T) {
 	}{
 		{
 			name:    "good",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 10); return x }(),
+			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0); return x }(),
 			orderID: uuid.New(),
 			volume:  1,
 			want:    nil,
@@ -70,34 +72,49 @@ func TestStoragePlace_Store(t *testing.T) {
 		},
 		{
 			name:    "bad overweight",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 1); return x }(),
+			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 1, 0); return x }(),
 			orderID: uuid.New(),
 			volume:  10,
 			want:    errs.ErrValueIsOutOfRange,
 		},
 		{
 			name:    "bad order uuid",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 10); return x }(),
+			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0); return x }(),
 			orderID: uuid.Nil,
 			volume:  1,
 			want:    errs.ErrValueIsInvalid,
 		},
 		{
-			name:    "bad occupied",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 10); x.Store(uuid.New(), 1); return x }(),
+			name: "bad not enough free volume",
+			place: func() *StoragePlace {
+				x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0)
+				x.Store(uuid.New(), 1, 0, order.HandlingAmbient)
+				return x
+			}(),
 			orderID: uuid.New(),
 			volume:  10,
-			want:    ErrStoragePlaceIsOccupied,
+			want:    ErrNotEnoughFreeVolume,
+		},
+		{
+			name: "bad already stored",
+			place: func() *StoragePlace {
+				x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0)
+				x.Store(id, 1, 0, order.HandlingAmbient)
+				return x
+			}(),
+			orderID: id,
+			volume:  1,
+			want:    ErrOrderAlreadyStored,
 		},
 	}
 
 	assert := assert.New(t)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.place.Store(tt.orderID, tt.volume); err != nil {
+			if err := tt.place.Store(tt.orderID, tt.volume, 0, order.HandlingAmbient); err != nil {
 				assert.ErrorIs(err, tt.want)
 			} else {
-				assert.NotEmpty(tt.place.OrderID())
+				assert.Contains(tt.place.OrderIDs(), tt.orderID)
 			}
 		})
 	}
@@ -105,10 +122,10 @@ func TestStoragePlace_Store(t *testing.T) {
 
 func TestStoragePlace_Equals(t *testing.T) {
 	require := require.New(t)
-	sp1, err := NewStoragePlace("first", 10)
+	sp1, err := NewStoragePlace("first", StoragePlaceKindAmbient, 10, 0)
 	require.NoError(err)
 
-	sp2, err := NewStoragePlace("second", 10)
+	sp2, err := NewStoragePlace("second", StoragePlaceKindAmbient, 10, 0)
 	require.NoError(err)
 
 	tests := []struct {
@@ -140,28 +157,43 @@ func TestStoragePlace_CanStore(t *testing.T) {
 	}{
 		{
 			name:    "good ok",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 10); return x }(),
+			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0); return x }(),
 			volume:  1,
 			want:    true,
 			wantErr: nil,
 		},
 		{
 			name:    "good not ok",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 1); return x }(),
+			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 1, 0); return x }(),
 			volume:  10,
 			want:    false,
 			wantErr: nil,
 		},
 		{
-			name:    "good occupied",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 10); x.Store(uuid.New(), 1); return x }(),
-			volume:  1,
+			name: "good shared",
+			place: func() *StoragePlace {
+				x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0)
+				x.Store(uuid.New(), 1, 0, order.HandlingAmbient)
+				return x
+			}(),
+			volume:  9,
+			want:    true,
+			wantErr: nil,
+		},
+		{
+			name: "good no free volume",
+			place: func() *StoragePlace {
+				x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0)
+				x.Store(uuid.New(), 2, 0, order.HandlingAmbient)
+				return x
+			}(),
+			volume:  9,
 			want:    false,
 			wantErr: nil,
 		},
 		{
 			name:    "bad volume invalid",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 10); return x }(),
+			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0); return x }(),
 			volume:  -1,
 			want:    false,
 			wantErr: errs.ErrValueIsInvalid,
@@ -178,7 +210,7 @@ func TestStoragePlace_CanStore(t *testing.T) {
 	assert := assert.New(t)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.place.CanStore(tt.volume)
+			got, err := tt.place.CanStore(tt.volume, 0, order.HandlingAmbient)
 			if err != nil {
 				assert.ErrorIs(err, tt.wantErr)
 			}
@@ -196,14 +228,22 @@ func TestStoragePlace_Clear(t *testing.T) {
 		want    error
 	}{
 		{
-			name:    "good",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 10); x.Store(id, 1); return x }(),
+			name: "good",
+			place: func() *StoragePlace {
+				x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0)
+				x.Store(id, 1, 0, order.HandlingAmbient)
+				return x
+			}(),
 			orderID: id,
 			want:    nil,
 		},
 		{
-			name:    "bad not found",
-			place:   func() *StoragePlace { x, _ := NewStoragePlace("bag", 10); x.Store(id, 1); return x }(),
+			name: "bad not found",
+			place: func() *StoragePlace {
+				x, _ := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0)
+				x.Store(id, 1, 0, order.HandlingAmbient)
+				return x
+			}(),
 			orderID: uuid.New(),
 			want:    errs.ErrObjectNotFound,
 		},
@@ -227,9 +267,114 @@ func TestStoragePlace_Clear(t *testing.T) {
 			if err := tt.place.Clear(tt.orderID); err != nil {
 				assert.ErrorIs(err, tt.want)
 			} else {
-				assert.Empty(tt.place.OrderID())
-				assert.NoError(tt.place.Store(tt.orderID, 1))
+				assert.NotContains(tt.place.OrderIDs(), tt.orderID)
+				assert.NoError(tt.place.Store(tt.orderID, 1, 0, order.HandlingAmbient))
 			}
 		})
 	}
 }
+
+func TestStoragePlace_FreeVolume(t *testing.T) {
+	assert := assert.New(t)
+
+	trailer, err := NewStoragePlace("Прицеп", StoragePlaceKindAmbient, 100, 0)
+	assert.NoError(err)
+
+	first, second := uuid.New(), uuid.New()
+	assert.NoError(trailer.Store(first, 1, 0, order.HandlingAmbient))
+	assert.NoError(trailer.Store(second, 30, 0, order.HandlingAmbient))
+	assert.True(trailer.IsOccupied())
+	assert.Equal(31, trailer.OccupiedVolume())
+	assert.Equal(69, trailer.FreeVolume())
+	assert.Equal([]StoredOrder{RestoreStoredOrder(first, 1, 0), RestoreStoredOrder(second, 30, 0)}, trailer.Orders())
+
+	assert.NoError(trailer.Clear(first))
+	assert.Equal(70, trailer.FreeVolume())
+	assert.False(trailer.Contains(first))
+	assert.True(trailer.Contains(second))
+
+	assert.NoError(trailer.Clear(second))
+	assert.False(trailer.IsOccupied())
+	assert.Equal(100, trailer.FreeVolume())
+}
+
+func TestStoragePlace_MaxWeight(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, -1)
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+
+	bag, err := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 5000)
+	assert.NoError(err)
+	assert.Equal(5000, bag.MaxWeight())
+
+	// Маленький, но тяжелый заказ не помещается по весу
+	ok, err := bag.CanStore(1, 6000, order.HandlingAmbient)
+	assert.NoError(err)
+	assert.False(ok)
+	assert.ErrorIs(bag.Store(uuid.New(), 1, 6000, order.HandlingAmbient), errs.ErrValueIsOutOfRange)
+
+	assert.NoError(bag.Store(uuid.New(), 1, 3000, order.HandlingAmbient))
+	assert.Equal(3000, bag.OccupiedWeight())
+	ok, err = bag.CanStore(1, 2000, order.HandlingAmbient)
+	assert.NoError(err)
+	assert.True(ok)
+	assert.ErrorIs(bag.Store(uuid.New(), 1, 2500, order.HandlingAmbient), ErrNotEnoughFreeWeight)
+
+	_, err = bag.CanStore(1, -1, order.HandlingAmbient)
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+
+	// Без ограничения вес не проверяется
+	trailer, err := NewStoragePlace("trailer", StoragePlaceKindAmbient, 10, 0)
+	assert.NoError(err)
+	ok, err = trailer.CanStore(1, 1000000, order.HandlingAmbient)
+	assert.NoError(err)
+	assert.True(ok)
+}
+
+func TestStoragePlace_Kind(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewStoragePlace("bag", StoragePlaceKindEmpty, 10, 0)
+	assert.ErrorIs(err, errs.ErrValueIsRequired)
+	_, err = NewStoragePlace("bag", StoragePlaceKind("Heated"), 10, 0)
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+
+	tests := []struct {
+		kind     StoragePlaceKind
+		handling order.Handling
+		want     bool
+	}{
+		{StoragePlaceKindAmbient, order.HandlingAmbient, true},
+		{StoragePlaceKindAmbient, order.HandlingInsulated, false},
+		{StoragePlaceKindAmbient, order.HandlingRefrigerated, false},
+		{StoragePlaceKindInsulated, order.HandlingAmbient, true},
+		{StoragePlaceKindInsulated, order.HandlingInsulated, true},
+		{StoragePlaceKindInsulated, order.HandlingRefrigerated, false},
+		{StoragePlaceKindRefrigerated, order.HandlingInsulated, true},
+		{StoragePlaceKindRefrigerated, order.HandlingRefrigerated, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind.String()+"/"+tt.handling.String(), func(t *testing.T) {
+			place, err := NewStoragePlace("place", tt.kind, 10, 0)
+			assert.NoError(err)
+			assert.Equal(tt.kind, place.Kind())
+
+			got, err := place.CanStore(1, 0, tt.handling)
+			assert.NoError(err)
+			assert.Equal(tt.want, got)
+
+			err = place.Store(uuid.New(), 1, 0, tt.handling)
+			if tt.want {
+				assert.NoError(err)
+			} else {
+				assert.ErrorIs(err, ErrStoragePlaceKindMismatch)
+			}
+		})
+	}
+
+	bag, err := NewStoragePlace("bag", StoragePlaceKindAmbient, 10, 0)
+	assert.NoError(err)
+	_, err = bag.CanStore(1, 0, order.HandlingEmpty)
+	assert.ErrorIs(err, errs.ErrValueIsInvalid)
+}
@@ -0,0 +1,126 @@
+package courier
+
+import (
+	"delivery/internal/pkg/errs"
+)
+
+const (
+	TransportTypeEmpty      TransportType = ""
+	TransportTypePedestrian TransportType = "Pedestrian"
+	TransportTypeBicycle    TransportType = "Bicycle"
+	TransportTypeScooter    TransportType = "Scooter"
+	TransportTypeCar        TransportType = "Car"
+)
+
+// TransportType determines the speed range and the payload of the courier
+// and the storage places the courier starts with.
+type TransportType string
+
+type transportSpec struct {
+	minSpeed, maxSpeed int
+	// maxPayload - сколько граммов курьер может везти одновременно
+	maxPayload int
+	storageKit []storagePlaceSpec
+}
+
+type storagePlaceSpec struct {
+	name        string
+	kind        StoragePlaceKind
+	totalVolume int
+	maxWeight   int
+}
+
+var transportSpecs = map[TransportType]transportSpec{
+	TransportTypePedestrian: {
+		minSpeed: 1, maxSpeed: 2, maxPayload: 10000,
+		storageKit: []storagePlaceSpec{{"Сумка", StoragePlaceKindInsulated, 10, 8000}},
+	},
+	TransportTypeBicycle: {
+		minSpeed: 2, maxSpeed: 3, maxPayload: 20000,
+		storageKit: []storagePlaceSpec{
+			{"Сумка", StoragePlaceKindInsulated, 10, 8000},
+			{"Багажник", StoragePlaceKindAmbient, 30, 15000},
+		},
+	},
+	TransportTypeScooter: {
+		minSpeed: 2, maxSpeed: 4, maxPayload: 40000,
+		storageKit: []storagePlaceSpec{
+			{"Сумка", StoragePlaceKindInsulated, 10, 8000},
+			{"Кофр", StoragePlaceKindAmbient, 20, 25000},
+		},
+	},
+	TransportTypeCar: {
+		minSpeed: 3, maxSpeed: 5, maxPayload: 200000,
+		storageKit: []storagePlaceSpec{
+			{"Сумка", StoragePlaceKindInsulated, 10, 8000},
+			{"Багажник", StoragePlaceKindAmbient, 50, 150000},
+		},
+	},
+}
+
+// TransportTypeForSpeed picks the transport by speed the way couriers were set up
+// before transport types: 1 - on foot, 2 - bicycle, 3 and faster - car.
+func TransportTypeForSpeed(speed int) TransportType {
+	switch {
+	case speed <= 1:
+		return TransportTypePedestrian
+	case speed == 2:
+		return TransportTypeBicycle
+	default:
+		return TransportTypeCar
+	}
+}
+
+func (t TransportType) Equals(other TransportType) bool {
+	return t == other
+}
+
+func (t TransportType) IsEmpty() bool {
+	return t == TransportTypeEmpty
+}
+
+func (t TransportType) IsValid() bool {
+	_, ok := transportSpecs[t]
+	return ok
+}
+
+func (t TransportType) String() string {
+	return string(t)
+}
+
+// SpeedRange returns the allowed speed, both bounds are inclusive.
+func (t TransportType) SpeedRange() (minSpeed, maxSpeed int) {
+	spec := transportSpecs[t]
+	return spec.minSpeed, spec.maxSpeed
+}
+
+// MaxPayload is the total weight in grams the courier can carry on this transport.
+func (t TransportType) MaxPayload() int {
+	return transportSpecs[t].maxPayload
+}
+
+func (t TransportType) ValidateSpeed(speed int) error {
+	if !t.IsValid() {
+		return errs.NewValueIsInvalidError("transportType")
+	}
+
+	minSpeed, maxSpeed := t.SpeedRange()
+	if speed < minSpeed || speed > maxSpeed {
+		return errs.NewValueIsOutOfRangeError("speed", speed, minSpeed, maxSpeed)
+	}
+	return nil
+}
+
+// DefaultStoragePlaces makes the storage places the courier gets on this transport.
+func (t TransportType) DefaultStoragePlaces() ([]*StoragePlace, error) {
+	spec := transportSpecs[t]
+	places := make([]*StoragePlace, 0, len(spec.storageKit))
+	for _, kit := range spec.storageKit {
+		place, err := NewStoragePlace(kit.name, kit.kind, kit.totalVolume, kit.maxWeight)
+		if err != nil {
+			return nil, err
+		}
+		places = append(places, place)
+	}
+	return places, nil
+}
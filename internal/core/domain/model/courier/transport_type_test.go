@@ -0,0 +1,71 @@
+package courier_test
+
+import (
+	"testing"
+
+	. "delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransportType_ValidateSpeed(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport TransportType
+		speed     int
+		want      error
+	}{
+		{name: "good pedestrian", transport: TransportTypePedestrian, speed: 1, want: nil},
+		{name: "good bicycle", transport: TransportTypeBicycle, speed: 3, want: nil},
+		{name: "good car", transport: TransportTypeCar, speed: 5, want: nil},
+		{name: "bad slow car", transport: TransportTypeCar, speed: 1, want: errs.ErrValueIsOutOfRange},
+		{name: "bad fast pedestrian", transport: TransportTypePedestrian, speed: 3, want: errs.ErrValueIsOutOfRange},
+		{name: "bad empty", transport: TransportTypeEmpty, speed: 1, want: errs.ErrValueIsInvalid},
+	}
+
+	assert := assert.New(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.transport.ValidateSpeed(tt.speed)
+			if tt.want == nil {
+				assert.NoError(err)
+			} else {
+				assert.ErrorIs(err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransportType_DefaultStoragePlaces(t *testing.T) {
+	assert := assert.New(t)
+
+	places, err := TransportTypeBicycle.DefaultStoragePlaces()
+	assert.NoError(err)
+	if assert.Len(places, 2) {
+		assert.Equal("Сумка", places[0].Name())
+		assert.Equal(10, places[0].TotalVolume())
+		assert.Equal("Багажник", places[1].Name())
+		assert.Equal(30, places[1].TotalVolume())
+	}
+
+	// Каждый раз новые места хранения
+	again, err := TransportTypeBicycle.DefaultStoragePlaces()
+	assert.NoError(err)
+	assert.False(places[0].Equals(again[0]))
+
+	places, err = TransportType("Rocket").DefaultStoragePlaces()
+	assert.NoError(err)
+	assert.Empty(places)
+}
+
+func TestTransportTypeForSpeed(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(TransportTypePedestrian, TransportTypeForSpeed(1))
+	assert.Equal(TransportTypeBicycle, TransportTypeForSpeed(2))
+	assert.Equal(TransportTypeCar, TransportTypeForSpeed(3))
+	for speed := 1; speed <= 5; speed++ {
+		assert.NoError(TransportTypeForSpeed(speed).ValidateSpeed(speed))
+	}
+}
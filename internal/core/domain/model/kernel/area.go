@@ -0,0 +1,219 @@
+package kernel
+
+import (
+	"crypto/rand"
+	"errors"
+	"math"
+	"math/big"
+
+	"delivery/internal/pkg/errs"
+)
+
+const (
+	DefaultAreaMin = 1
+	DefaultAreaMax = 10
+)
+
+// distanceEpsilon absorbs the floating point error before rounding the distance up.
+const distanceEpsilon = 1e-9
+
+// Area is the rectangular delivery area which bounds the Location coordinates.
+// The bounds are inclusive and are configured per deployment.
+// A geographic area (WithGeoBounds) lays the grid over the map: every cell covers a part
+// of GeoBounds, and Location carries the exact GeoPoint.
+type Area struct {
+	minX, minY int
+	maxX, maxY int
+	bounds     GeoBounds
+	valid      bool
+}
+
+func NewArea(minX, minY, maxX, maxY int) (Area, error) {
+	if minX < 0 {
+		return Area{}, errs.NewValueIsInvalidError("Area.minX")
+	}
+	if minY < 0 {
+		return Area{}, errs.NewValueIsInvalidError("Area.minY")
+	}
+	if maxX < minX {
+		return Area{}, errs.NewValueIsOutOfRangeError("Area.maxX", maxX, minX, math.MaxInt)
+	}
+	if maxY < minY {
+		return Area{}, errs.NewValueIsOutOfRangeError("Area.maxY", maxY, minY, math.MaxInt)
+	}
+
+	return Area{minX: minX, minY: minY, maxX: maxX, maxY: maxY, valid: true}, nil
+}
+
+func DefaultArea() Area {
+	return Area{minX: DefaultAreaMin, minY: DefaultAreaMin, maxX: DefaultAreaMax, maxY: DefaultAreaMax, valid: true}
+}
+
+// WithGeoBounds makes the area geographic, the grid is stretched over bounds.
+func (a Area) WithGeoBounds(bounds GeoBounds) (Area, error) {
+	if !a.valid {
+		return Area{}, errs.NewValueIsRequiredError("area")
+	}
+	if !bounds.IsValid() {
+		return Area{}, errs.NewValueIsRequiredError("bounds")
+	}
+
+	a.bounds = bounds
+	return a, nil
+}
+
+// NewLocation creates the location in the cell (x, y). In a geographic area
+// the location gets the GeoPoint of the cell centre.
+func (a Area) NewLocation(x, y int) (Location, error) {
+	if x < a.minX || x > a.maxX {
+		return Location{}, errs.NewValueIsOutOfRangeError("Location.x", x, a.minX, a.maxX)
+	}
+
+	if y < a.minY || y > a.maxY {
+		return Location{}, errs.NewValueIsOutOfRangeError("Location.y", y, a.minY, a.maxY)
+	}
+
+	if a.IsGeographic() {
+		return Location{x: x, y: y, point: a.cellCenter(x, y), valid: true}, nil
+	}
+	return Location{x: x, y: y, valid: true}, nil
+}
+
+// NewGeoLocation creates the location of the point on the map, the cell is found by GeoBounds.
+func (a Area) NewGeoLocation(point GeoPoint) (Location, error) {
+	if !a.IsGeographic() {
+		return Location{}, errs.NewValueIsInvalidErrorWithCause("Area", errors.New("area has no geo bounds"))
+	}
+	if !point.IsValid() {
+		return Location{}, errs.NewValueIsRequiredError("point")
+	}
+	if !a.bounds.Contains(point) {
+		return Location{}, errs.NewValueIsOutOfRangeError("Location.point", point,
+			[2]float64{a.bounds.South(), a.bounds.West()}, [2]float64{a.bounds.North(), a.bounds.East()})
+	}
+
+	x, y := a.cellOf(point)
+	return Location{x: x, y: y, point: point, valid: true}, nil
+}
+
+// Locate returns the location of the point without checking the area bounds, the cell
+// is clamped to the grid edge. Couriers may stand outside the area after it shrinks.
+func (a Area) Locate(point GeoPoint) Location {
+	if !a.IsGeographic() || !point.IsValid() {
+		return Location{}
+	}
+
+	x, y := a.cellOf(point)
+	return Location{x: x, y: y, point: point, valid: true}
+}
+
+// Distance measures the distance in grid steps of the area. Between geographic locations
+// it is the haversine distance divided by UnitMeters, otherwise the Manhattan distance.
+func (a Area) Distance(from, to Location) (int, error) {
+	if !a.IsGeographic() || !from.IsGeographic() || !to.IsGeographic() {
+		return from.DistanceTo(to)
+	}
+
+	meters, err := from.point.DistanceTo(to.point)
+	if err != nil {
+		return 0, err
+	}
+	return int(math.Ceil(meters/a.UnitMeters() - distanceEpsilon)), nil
+}
+
+func (a Area) NewRandomLocation() Location {
+	if a.IsGeographic() {
+		return a.newRandomGeoLocation()
+	}
+
+	rnd := func(lo, hi int) int {
+		n, _ := rand.Int(rand.Reader, big.NewInt(int64(hi-lo+1)))
+		return int(n.Int64()) + lo
+	}
+
+	res, err := a.NewLocation(rnd(a.minX, a.maxX), rnd(a.minY, a.maxY))
+	if err != nil {
+		panic(err) // should never happen
+	}
+
+	return res
+}
+
+func (a Area) newRandomGeoLocation() Location {
+	const precision = 1 << 30
+	rnd := func(lo, hi float64) float64 {
+		n, _ := rand.Int(rand.Reader, big.NewInt(precision+1))
+		return lo + (hi-lo)*float64(n.Int64())/precision
+	}
+
+	point, err := NewGeoPoint(rnd(a.bounds.South(), a.bounds.North()), rnd(a.bounds.West(), a.bounds.East()))
+	if err != nil {
+		panic(err) // should never happen
+	}
+	res, err := a.NewGeoLocation(point)
+	if err != nil {
+		panic(err) // should never happen
+	}
+
+	return res
+}
+
+// UnitMeters is the length of one grid step on the map, the mean of the cell width and height.
+// Distance measures geographic distances in these units, so the courier speed
+// is still given in cells per tick.
+func (a Area) UnitMeters() float64 {
+	if !a.IsGeographic() {
+		return 0
+	}
+
+	midLat := (a.bounds.South() + a.bounds.North()) / 2
+	west, _ := NewGeoPoint(midLat, a.bounds.West())
+	east, _ := NewGeoPoint(midLat, a.bounds.East())
+	south, _ := NewGeoPoint(a.bounds.South(), a.bounds.West())
+	north, _ := NewGeoPoint(a.bounds.North(), a.bounds.West())
+	width, _ := west.DistanceTo(east)
+	height, _ := south.DistanceTo(north)
+
+	return (width/float64(a.columns()) + height/float64(a.rows())) / 2
+}
+
+func (a Area) cellOf(point GeoPoint) (int, int) {
+	fx := (point.Lon() - a.bounds.West()) / (a.bounds.East() - a.bounds.West())
+	fy := (point.Lat() - a.bounds.South()) / (a.bounds.North() - a.bounds.South())
+
+	x := a.minX + max(0, min(int(math.Floor(fx*float64(a.columns()))), a.columns()-1))
+	y := a.minY + max(0, min(int(math.Floor(fy*float64(a.rows()))), a.rows()-1))
+	return x, y
+}
+
+func (a Area) cellCenter(x, y int) GeoPoint {
+	lon := a.bounds.West() + (float64(x-a.minX)+0.5)*(a.bounds.East()-a.bounds.West())/float64(a.columns())
+	lat := a.bounds.South() + (float64(y-a.minY)+0.5)*(a.bounds.North()-a.bounds.South())/float64(a.rows())
+	return GeoPoint{lat: lat, lon: lon, valid: true}
+}
+
+func (a Area) columns() int { return a.maxX - a.minX + 1 }
+
+func (a Area) rows() int { return a.maxY - a.minY + 1 }
+
+func (a Area) Contains(location Location) bool {
+	return location.IsValid() &&
+		location.X() >= a.minX && location.X() <= a.maxX &&
+		location.Y() >= a.minY && location.Y() <= a.maxY
+}
+
+func (a Area) Equals(other Area) bool { return a == other }
+
+func (a Area) IsValid() bool { return a.valid }
+
+func (a Area) IsGeographic() bool { return a.bounds.IsValid() }
+
+func (a Area) GeoBounds() GeoBounds { return a.bounds }
+
+func (a Area) MinX() int { return a.minX }
+
+func (a Area) MinY() int { return a.minY }
+
+func (a Area) MaxX() int { return a.maxX }
+
+func (a Area) MaxY() int { return a.maxY }
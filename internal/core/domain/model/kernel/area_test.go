@@ -0,0 +1,94 @@
+package kernel_test
+
+import (
+	"errors"
+	"testing"
+
+	. "delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewArea(t *testing.T) {
+	tests := []struct {
+		name       string
+		minX, minY int
+		maxX, maxY int
+		wantErr    error
+	}{
+		{name: "good", minX: 1, minY: 1, maxX: 100, maxY: 50, wantErr: nil},
+		{name: "single point", minX: 3, minY: 3, maxX: 3, maxY: 3, wantErr: nil},
+		{name: "negative min", minX: -1, minY: 1, maxX: 10, maxY: 10, wantErr: errs.ErrValueIsInvalid},
+		{name: "max less than min", minX: 5, minY: 1, maxX: 4, maxY: 10, wantErr: errs.ErrValueIsOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewArea(tt.minX, tt.minY, tt.maxX, tt.maxY)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got error %v", err)
+				assert.False(t, got.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, got.IsValid())
+			assert.Equal(t, tt.maxX, got.MaxX())
+			assert.Equal(t, tt.maxY, got.MaxY())
+		})
+	}
+}
+
+func TestArea_NewLocation(t *testing.T) {
+	area, err := NewArea(0, 5, 20, 8)
+	assert.NoError(t, err)
+
+	loc, err := area.NewLocation(20, 5)
+	assert.NoError(t, err)
+	assert.True(t, area.Contains(loc))
+
+	_, err = area.NewLocation(21, 5)
+	assert.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+	_, err = area.NewLocation(0, 4)
+	assert.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+}
+
+func TestArea_NewRandomLocationReachesBounds(t *testing.T) {
+	// Зона 2x1: за достаточное число попыток должны выпасть обе границы по x
+	area, err := NewArea(1, 1, 2, 1)
+	assert.NoError(t, err)
+
+	seen := map[int]bool{}
+	for i := 0; i < 200 && len(seen) < 2; i++ {
+		loc := area.NewRandomLocation()
+		assert.True(t, area.Contains(loc))
+		seen[loc.X()] = true
+	}
+	assert.True(t, seen[1])
+	assert.True(t, seen[2])
+}
+
+func TestNewLocation_DefaultArea(t *testing.T) {
+	// Без явной зоны координаты проверяются по зоне по умолчанию
+	_, err := NewLocation(DefaultAreaMax, DefaultAreaMin)
+	assert.NoError(t, err)
+
+	_, err = NewLocation(50, 100)
+	assert.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+	area, err := NewArea(1, 1, 100, 100)
+	assert.NoError(t, err)
+	_, err = area.NewLocation(50, 100)
+	assert.NoError(t, err)
+
+	assert.True(t, DefaultArea().Contains(NewRandomLocation()))
+}
+
+func TestRestoreLocation(t *testing.T) {
+	// Сохраненная координата остается валидной, даже если зона сузилась
+	loc := RestoreLocation(50, 50)
+	assert.True(t, loc.IsValid())
+	assert.False(t, DefaultArea().Contains(loc))
+}
@@ -0,0 +1,51 @@
+package kernel
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+)
+
+// GeoBounds is the geographic rectangle of the delivery area, it does not cross the 180th meridian.
+type GeoBounds struct {
+	south, west float64
+	north, east float64
+	valid       bool
+}
+
+func NewGeoBounds(south, west, north, east float64) (GeoBounds, error) {
+	sw, err := NewGeoPoint(south, west)
+	if err != nil {
+		return GeoBounds{}, err
+	}
+	ne, err := NewGeoPoint(north, east)
+	if err != nil {
+		return GeoBounds{}, err
+	}
+	if sw.Lat() >= ne.Lat() {
+		return GeoBounds{}, errs.NewValueIsInvalidErrorWithCause("GeoBounds", errors.New("south must be less than north"))
+	}
+	if sw.Lon() >= ne.Lon() {
+		return GeoBounds{}, errs.NewValueIsInvalidErrorWithCause("GeoBounds", errors.New("west must be less than east"))
+	}
+
+	return GeoBounds{south: south, west: west, north: north, east: east, valid: true}, nil
+}
+
+func (b GeoBounds) Contains(point GeoPoint) bool {
+	return point.IsValid() &&
+		point.Lat() >= b.south && point.Lat() <= b.north &&
+		point.Lon() >= b.west && point.Lon() <= b.east
+}
+
+func (b GeoBounds) Equals(other GeoBounds) bool { return b == other }
+
+func (b GeoBounds) IsValid() bool { return b.valid }
+
+func (b GeoBounds) South() float64 { return b.south }
+
+func (b GeoBounds) West() float64 { return b.west }
+
+func (b GeoBounds) North() float64 { return b.north }
+
+func (b GeoBounds) East() float64 { return b.east }
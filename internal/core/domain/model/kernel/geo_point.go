@@ -0,0 +1,96 @@
+package kernel
+
+import (
+	"errors"
+	"math"
+
+	"delivery/internal/pkg/errs"
+)
+
+// EarthRadiusMeters is the mean radius of the Earth used by the haversine formula.
+const EarthRadiusMeters = 6371008.8
+
+// GeoPoint is a point on the Earth surface in degrees of latitude and longitude (WGS 84).
+type GeoPoint struct {
+	lat, lon float64
+	valid    bool
+}
+
+func NewGeoPoint(lat, lon float64) (GeoPoint, error) {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return GeoPoint{}, errs.NewValueIsOutOfRangeError("GeoPoint.lat", lat, -90, 90)
+	}
+
+	if math.IsNaN(lon) || lon < -180 || lon > 180 {
+		return GeoPoint{}, errs.NewValueIsOutOfRangeError("GeoPoint.lon", lon, -180, 180)
+	}
+
+	return GeoPoint{lat: lat, lon: lon, valid: true}, nil
+}
+
+// DistanceTo returns the great-circle distance in metres by the haversine formula.
+func (p GeoPoint) DistanceTo(target GeoPoint) (float64, error) {
+	if !p.valid {
+		cause := errors.New("source point not initialized")
+		return 0, errs.NewValueIsInvalidErrorWithCause("GeoPoint", cause)
+	}
+
+	if !target.valid {
+		cause := errors.New("target point not initialized")
+		return 0, errs.NewValueIsInvalidErrorWithCause("GeoPoint", cause)
+	}
+
+	return EarthRadiusMeters * p.angleTo(target), nil
+}
+
+// MoveTowards returns the point moved towards target by meters along the great circle.
+// When target is closer, target itself is returned.
+func (p GeoPoint) MoveTowards(target GeoPoint, meters float64) (GeoPoint, error) {
+	distance, err := p.DistanceTo(target)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	if meters < 0 {
+		return GeoPoint{}, errs.NewValueIsOutOfRangeError("meters", meters, 0, math.MaxFloat64)
+	}
+	if meters >= distance {
+		return target, nil
+	}
+
+	// Сферическая интерполяция между точками
+	delta := p.angleTo(target)
+	fraction := meters / distance
+	a := math.Sin((1-fraction)*delta) / math.Sin(delta)
+	b := math.Sin(fraction*delta) / math.Sin(delta)
+
+	lat1, lon1 := radians(p.lat), radians(p.lon)
+	lat2, lon2 := radians(target.lat), radians(target.lon)
+	x := a*math.Cos(lat1)*math.Cos(lon1) + b*math.Cos(lat2)*math.Cos(lon2)
+	y := a*math.Cos(lat1)*math.Sin(lon1) + b*math.Cos(lat2)*math.Sin(lon2)
+	z := a*math.Sin(lat1) + b*math.Sin(lat2)
+
+	lat := degrees(math.Atan2(z, math.Hypot(x, y)))
+	lon := degrees(math.Atan2(y, x))
+	return NewGeoPoint(lat, lon)
+}
+
+func (p GeoPoint) angleTo(target GeoPoint) float64 {
+	lat1, lat2 := radians(p.lat), radians(target.lat)
+	dLat := lat2 - lat1
+	dLon := radians(target.lon - p.lon)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * math.Asin(math.Sqrt(min(1, h)))
+}
+
+func (p GeoPoint) Equals(other GeoPoint) bool { return p == other }
+
+func (p GeoPoint) IsValid() bool { return p.valid }
+
+func (p GeoPoint) Lat() float64 { return p.lat }
+
+func (p GeoPoint) Lon() float64 { return p.lon }
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+
+func degrees(rad float64) float64 { return rad * 180 / math.Pi }
@@ -0,0 +1,167 @@
+package kernel_test
+
+import (
+	"testing"
+
+	. "delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGeoPoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lon float64
+		wantErr  error
+	}{
+		{name: "good", lat: 55.7558, lon: 37.6173, wantErr: nil},
+		{name: "poles and antimeridian", lat: -90, lon: 180, wantErr: nil},
+		{name: "bad lat", lat: 90.1, lon: 0, wantErr: errs.ErrValueIsOutOfRange},
+		{name: "bad lon", lat: 0, lon: -180.1, wantErr: errs.ErrValueIsOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewGeoPoint(tt.lat, tt.lon)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, got.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.lat, got.Lat())
+			assert.Equal(t, tt.lon, got.Lon())
+		})
+	}
+}
+
+func TestGeoPoint_DistanceTo(t *testing.T) {
+	// Один градус меридиана ~ 111.2 км
+	a, _ := NewGeoPoint(0, 0)
+	b, _ := NewGeoPoint(1, 0)
+	got, err := a.DistanceTo(b)
+	assert.NoError(t, err)
+	assert.InDelta(t, 111195, got, 1)
+
+	// Москва - Санкт-Петербург ~ 634 км
+	moscow, _ := NewGeoPoint(55.7558, 37.6173)
+	spb, _ := NewGeoPoint(59.9343, 30.3351)
+	got, err = moscow.DistanceTo(spb)
+	assert.NoError(t, err)
+	assert.InDelta(t, 634000, got, 2000)
+
+	_, err = moscow.DistanceTo(GeoPoint{})
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestGeoPoint_MoveTowards(t *testing.T) {
+	a, _ := NewGeoPoint(0, 0)
+	b, _ := NewGeoPoint(0, 1)
+	total, _ := a.DistanceTo(b)
+
+	// Половина пути по экватору
+	half, err := a.MoveTowards(b, total/2)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0, half.Lat(), 1e-9)
+	assert.InDelta(t, 0.5, half.Lon(), 1e-9)
+
+	// Шаг длиннее оставшегося пути - точно в цель
+	got, err := half.MoveTowards(b, total)
+	assert.NoError(t, err)
+	assert.True(t, got.Equals(b))
+
+	_, err = a.MoveTowards(b, -1)
+	assert.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+}
+
+func TestArea_GeoLocation(t *testing.T) {
+	area, _ := NewArea(1, 1, 10, 10)
+	bounds, err := NewGeoBounds(55.0, 37.0, 56.0, 38.0)
+	assert.NoError(t, err)
+	area, err = area.WithGeoBounds(bounds)
+	assert.NoError(t, err)
+	assert.True(t, area.IsGeographic())
+
+	// Клетка вычисляется по точке
+	point, _ := NewGeoPoint(55.05, 37.95)
+	loc, err := area.NewGeoLocation(point)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, loc.X())
+	assert.Equal(t, 1, loc.Y())
+	assert.True(t, loc.Point().Equals(point))
+
+	// Северо-восточный угол попадает в последнюю клетку
+	corner, _ := NewGeoPoint(56.0, 38.0)
+	loc, err = area.NewGeoLocation(corner)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, loc.X())
+	assert.Equal(t, 10, loc.Y())
+
+	// Координата сетки получает центр клетки
+	loc, err = area.NewLocation(1, 1)
+	assert.NoError(t, err)
+	assert.InDelta(t, 55.05, loc.Point().Lat(), 1e-9)
+	assert.InDelta(t, 37.05, loc.Point().Lon(), 1e-9)
+
+	outside, _ := NewGeoPoint(54.9, 37.5)
+	_, err = area.NewGeoLocation(outside)
+	assert.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+	random := area.NewRandomLocation()
+	assert.True(t, random.IsGeographic())
+	assert.True(t, area.Contains(random))
+
+	_, err = DefaultArea().NewGeoLocation(point)
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestArea_DistanceGeographic(t *testing.T) {
+	area, _ := NewArea(1, 1, 10, 10)
+	bounds, _ := NewGeoBounds(0, 0, 1, 1)
+	area, _ = area.WithGeoBounds(bounds)
+
+	// 0.1 градуса у экватора ~ один шаг сетки
+	from, _ := area.NewGeoLocation(mustGeoPoint(0.5, 0.1))
+	to, _ := area.NewGeoLocation(mustGeoPoint(0.5, 0.6))
+	got, err := area.Distance(from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, got)
+
+	// По диагонали гаверсинус короче манхэттенского расстояния
+	to, _ = area.NewGeoLocation(mustGeoPoint(0.9, 0.5))
+	got, err = area.Distance(from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, got)
+	manhattan, err := from.DistanceTo(to)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, manhattan)
+
+	// Зона без местности считает по клеткам
+	got, err = DefaultArea().Distance(from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, manhattan, got)
+}
+
+func TestArea_Locate(t *testing.T) {
+	area, _ := NewArea(1, 1, 10, 10)
+	bounds, _ := NewGeoBounds(0, 0, 1, 1)
+	area, _ = area.WithGeoBounds(bounds)
+
+	// Точка вне зоны остается валидной и прижимается к краю сетки
+	loc := area.Locate(mustGeoPoint(1.5, -0.5))
+	assert.True(t, loc.IsValid())
+	assert.Equal(t, 1, loc.X())
+	assert.Equal(t, 10, loc.Y())
+
+	assert.False(t, DefaultArea().Locate(mustGeoPoint(0.5, 0.5)).IsValid())
+}
+
+func mustGeoPoint(lat, lon float64) GeoPoint {
+	point, err := NewGeoPoint(lat, lon)
+	if err != nil {
+		panic(err)
+	}
+	return point
+}
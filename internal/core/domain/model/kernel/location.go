@@ -1,49 +1,40 @@
 package kernel
 
 import (
-	"crypto/rand"
 	"errors"
-	"math/big"
 
 	"delivery/internal/pkg/errs"
 )
 
-const (
-	minCoord = 1
-	maxCoord = 10
-)
-
 type Location struct {
 	x, y  int
+	point GeoPoint
 	valid bool
 }
 
+// NewLocation creates the location in the default area (1..10).
+// Locations of the configured area are created by Area.NewLocation.
 func NewLocation(x, y int) (Location, error) {
-	if x < minCoord || x > maxCoord {
-		return Location{}, errs.NewValueIsOutOfRangeError("Location.x", x, minCoord, maxCoord)
-	}
+	return DefaultArea().NewLocation(x, y)
+}
 
-	if y < minCoord || y > maxCoord {
-		return Location{}, errs.NewValueIsOutOfRangeError("Location.y", y, minCoord, maxCoord)
-	}
+// RestoreLocation restores a stored location without checking the area bounds:
+// the area may have changed after the location was written.
+func RestoreLocation(x, y int) Location {
+	return Location{x: x, y: y, valid: true}
+}
 
-	return Location{x: x, y: y, valid: true}, nil
+// RestoreGeoLocation restores a stored geographic location.
+func RestoreGeoLocation(x, y int, point GeoPoint) Location {
+	return Location{x: x, y: y, point: point, valid: true}
 }
 
 func NewRandomLocation() Location {
-	rnd := func() int {
-		n, _ := rand.Int(rand.Reader, big.NewInt(maxCoord-minCoord))
-		return int(n.Int64()) + minCoord
-	}
-
-	res, err := NewLocation(rnd(), rnd())
-	if err != nil {
-		panic(err) // should never happen
-	}
-
-	return res
+	return DefaultArea().NewRandomLocation()
 }
 
+// DistanceTo measures the Manhattan distance in grid cells.
+// Area.Distance takes the map into account.
 func (l Location) DistanceTo(target Location) (int, error) {
 	if !l.valid {
 		cause := errors.New("source location not initialized")
@@ -65,6 +56,11 @@ func (l Location) Equals(other Location) bool { return l == other }
 
 func (l Location) IsValid() bool { return l.valid }
 
+func (l Location) IsGeographic() bool { return l.point.IsValid() }
+
+// Point returns the point on the map, it is not initialized for grid locations.
+func (l Location) Point() GeoPoint { return l.point }
+
 func (l Location) X() int { return l.x }
 
 func (l Location) Y() int { return l.y }
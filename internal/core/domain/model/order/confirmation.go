@@ -0,0 +1,81 @@
+package order
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxConfirmationAttempts is how many wrong codes are accepted before the delivery fails.
+const MaxConfirmationAttempts = 3
+
+const confirmationCodeDigits = 4
+
+var (
+	ErrConfirmationRequired          = errors.New("order must be confirmed by the customer")
+	ErrConfirmationNotRequired       = errors.New("order does not require confirmation")
+	ErrConfirmationCodeMismatch      = errors.New("confirmation code does not match")
+	ErrConfirmationAttemptsExceeded  = errors.New("confirmation attempts exceeded")
+	ErrConfirmationAlreadyRequired   = errors.New("confirmation code already issued")
+	ErrConfirmationAlreadyFlagged    = errors.New("unconfirmed delivery already flagged")
+	ErrConfirmationTimeoutNotReached = errors.New("confirmation timeout not reached")
+)
+
+// Confirmation is the proof of delivery state: the hash of the code handed to the customer,
+// wrong attempts, the moment the courier arrived and whether the wait was flagged as overdue.
+// The code itself is only known to the customer, see OrderConfirmationCodeIssued.
+type Confirmation struct {
+	codeHash  string
+	attempts  int
+	arrivedAt *time.Time
+	overdue   bool
+}
+
+func RestoreConfirmation(codeHash string, attempts int, arrivedAt *time.Time, overdue bool) Confirmation {
+	return Confirmation{codeHash: codeHash, attempts: attempts, arrivedAt: arrivedAt, overdue: overdue}
+}
+
+// HashConfirmationCode salts the code with the order id, so equal codes of different
+// orders have different hashes.
+func HashConfirmationCode(orderID uuid.UUID, code string) string {
+	sum := sha256.Sum256([]byte(orderID.String() + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func newConfirmationCode() (string, error) {
+	limit := big.NewInt(1)
+	for range confirmationCodeDigits {
+		limit.Mul(limit, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", confirmationCodeDigits, n.Int64()), nil
+}
+
+func (c Confirmation) IsRequired() bool { return c.codeHash != "" }
+
+func (c Confirmation) CodeHash() string { return c.codeHash }
+
+func (c Confirmation) matches(orderID uuid.UUID, code string) bool {
+	hash := HashConfirmationCode(orderID, code)
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(c.codeHash)) == 1
+}
+
+func (c Confirmation) Attempts() int { return c.attempts }
+
+func (c Confirmation) AttemptsLeft() int { return max(0, MaxConfirmationAttempts-c.attempts) }
+
+// ArrivedAt is when the courier reached the customer, nil until then.
+func (c Confirmation) ArrivedAt() *time.Time { return c.arrivedAt }
+
+func (c Confirmation) IsOverdue() bool { return c.overdue }
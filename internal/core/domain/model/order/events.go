@@ -0,0 +1,262 @@
+package order
+
+import (
+	"time"
+
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventNameOrderCreated  = "order.created"
+	EventNameOrderAssigned = "order.assigned"
+	EventNameOrderPickedUp = "order.picked_up"
+
+	EventNameOrderAwaitingConfirmation   = "order.awaiting_confirmation"
+	EventNameOrderConfirmationCodeIssued = "order.confirmation_code_issued"
+	EventNameOrderConfirmationOverdue    = "order.confirmation_overdue"
+	EventNameOrderCompleted              = "order.completed"
+	EventNameOrderCancelled              = "order.cancelled"
+	EventNameOrderUnassigned             = "order.unassigned"
+	EventNameOrderDeliveryFailed         = "order.delivery_failed"
+	EventNameOrderRedeliveryScheduled    = "order.redelivery_scheduled"
+	EventNameOrderReturnedToSender       = "order.returned_to_sender"
+
+	EventNameOrderSLABreachPredicted = "order.sla_breach_predicted"
+)
+
+var (
+	_ ddd.DomainEvent = OrderCreated{}
+	_ ddd.DomainEvent = OrderAssigned{}
+	_ ddd.DomainEvent = OrderPickedUp{}
+	_ ddd.DomainEvent = OrderAwaitingConfirmation{}
+	_ ddd.DomainEvent = OrderConfirmationCodeIssued{}
+	_ ddd.DomainEvent = OrderConfirmationOverdue{}
+	_ ddd.DomainEvent = OrderCompleted{}
+	_ ddd.DomainEvent = OrderCancelled{}
+	_ ddd.DomainEvent = OrderUnassigned{}
+	_ ddd.DomainEvent = OrderDeliveryFailed{}
+	_ ddd.DomainEvent = OrderRedeliveryScheduled{}
+	_ ddd.DomainEvent = OrderReturnedToSender{}
+	_ ddd.DomainEvent = OrderSLABreachPredicted{}
+)
+
+type OrderCreated struct {
+	ID      uuid.UUID
+	OrderID uuid.UUID
+}
+
+func NewOrderCreated(orderID uuid.UUID) OrderCreated {
+	return OrderCreated{ID: uuid.New(), OrderID: orderID}
+}
+
+func (e OrderCreated) GetID() uuid.UUID       { return e.ID }
+func (e OrderCreated) GetName() string        { return EventNameOrderCreated }
+func (e OrderCreated) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderCreated) GetOrderStatus() Status { return StatusCreated }
+
+type OrderAssigned struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+}
+
+func NewOrderAssigned(orderID, courierID uuid.UUID) OrderAssigned {
+	return OrderAssigned{ID: uuid.New(), OrderID: orderID, CourierID: courierID}
+}
+
+func (e OrderAssigned) GetID() uuid.UUID       { return e.ID }
+func (e OrderAssigned) GetName() string        { return EventNameOrderAssigned }
+func (e OrderAssigned) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderAssigned) GetOrderStatus() Status { return StatusAssigned }
+
+type OrderPickedUp struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+}
+
+func NewOrderPickedUp(orderID, courierID uuid.UUID) OrderPickedUp {
+	return OrderPickedUp{ID: uuid.New(), OrderID: orderID, CourierID: courierID}
+}
+
+func (e OrderPickedUp) GetID() uuid.UUID       { return e.ID }
+func (e OrderPickedUp) GetName() string        { return EventNameOrderPickedUp }
+func (e OrderPickedUp) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderPickedUp) GetOrderStatus() Status { return StatusPickedUp }
+
+type OrderAwaitingConfirmation struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+}
+
+func NewOrderAwaitingConfirmation(orderID, courierID uuid.UUID) OrderAwaitingConfirmation {
+	return OrderAwaitingConfirmation{ID: uuid.New(), OrderID: orderID, CourierID: courierID}
+}
+
+func (e OrderAwaitingConfirmation) GetID() uuid.UUID       { return e.ID }
+func (e OrderAwaitingConfirmation) GetName() string        { return EventNameOrderAwaitingConfirmation }
+func (e OrderAwaitingConfirmation) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderAwaitingConfirmation) GetOrderStatus() Status { return StatusAwaitingConfirmation }
+
+// OrderConfirmationCodeIssued carries the code to be delivered to the customer.
+// The code is a secret: it is never serialized with the event and the outbox keeps it encrypted.
+type OrderConfirmationCodeIssued struct {
+	ID      uuid.UUID
+	OrderID uuid.UUID
+	Code    string `json:"-"`
+}
+
+var _ ddd.SecretEvent = OrderConfirmationCodeIssued{}
+
+func NewOrderConfirmationCodeIssued(orderID uuid.UUID, code string) OrderConfirmationCodeIssued {
+	return OrderConfirmationCodeIssued{ID: uuid.New(), OrderID: orderID, Code: code}
+}
+
+func (e OrderConfirmationCodeIssued) GetID() uuid.UUID  { return e.ID }
+func (e OrderConfirmationCodeIssued) GetName() string   { return EventNameOrderConfirmationCodeIssued }
+func (e OrderConfirmationCodeIssued) GetSecret() string { return e.Code }
+
+func (e OrderConfirmationCodeIssued) WithSecret(secret string) ddd.DomainEvent {
+	e.Code = secret
+	return e
+}
+
+// OrderConfirmationOverdue is raised when the courier waits for the code longer than allowed.
+type OrderConfirmationOverdue struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+	ArrivedAt time.Time
+}
+
+func NewOrderConfirmationOverdue(orderID, courierID uuid.UUID, arrivedAt time.Time) OrderConfirmationOverdue {
+	return OrderConfirmationOverdue{ID: uuid.New(), OrderID: orderID, CourierID: courierID, ArrivedAt: arrivedAt}
+}
+
+func (e OrderConfirmationOverdue) GetID() uuid.UUID { return e.ID }
+func (e OrderConfirmationOverdue) GetName() string  { return EventNameOrderConfirmationOverdue }
+
+// OrderCompleted carries the time passed since the order was created, zero when it is unknown.
+type OrderCompleted struct {
+	ID           uuid.UUID
+	OrderID      uuid.UUID
+	CourierID    uuid.UUID
+	DeliveryTime time.Duration
+}
+
+func NewOrderCompleted(orderID, courierID uuid.UUID, deliveryTime time.Duration) OrderCompleted {
+	return OrderCompleted{ID: uuid.New(), OrderID: orderID, CourierID: courierID, DeliveryTime: deliveryTime}
+}
+
+func (e OrderCompleted) GetID() uuid.UUID       { return e.ID }
+func (e OrderCompleted) GetName() string        { return EventNameOrderCompleted }
+func (e OrderCompleted) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderCompleted) GetOrderStatus() Status { return StatusCompleted }
+
+type OrderCancelled struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	CourierID *uuid.UUID
+}
+
+func NewOrderCancelled(orderID uuid.UUID, courierID *uuid.UUID) OrderCancelled {
+	return OrderCancelled{ID: uuid.New(), OrderID: orderID, CourierID: courierID}
+}
+
+func (e OrderCancelled) GetID() uuid.UUID       { return e.ID }
+func (e OrderCancelled) GetName() string        { return EventNameOrderCancelled }
+func (e OrderCancelled) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderCancelled) GetOrderStatus() Status { return StatusCancelled }
+
+// OrderUnassigned means the order is taken from the courier and returned to dispatch.
+type OrderUnassigned struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+}
+
+func NewOrderUnassigned(orderID, courierID uuid.UUID) OrderUnassigned {
+	return OrderUnassigned{ID: uuid.New(), OrderID: orderID, CourierID: courierID}
+}
+
+func (e OrderUnassigned) GetID() uuid.UUID       { return e.ID }
+func (e OrderUnassigned) GetName() string        { return EventNameOrderUnassigned }
+func (e OrderUnassigned) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderUnassigned) GetOrderStatus() Status { return StatusCreated }
+
+type OrderDeliveryFailed struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+	Reason    FailureReason
+	Attempt   int
+}
+
+func NewOrderDeliveryFailed(orderID, courierID uuid.UUID, reason FailureReason, attempt int) OrderDeliveryFailed {
+	return OrderDeliveryFailed{ID: uuid.New(), OrderID: orderID, CourierID: courierID, Reason: reason, Attempt: attempt}
+}
+
+func (e OrderDeliveryFailed) GetID() uuid.UUID       { return e.ID }
+func (e OrderDeliveryFailed) GetName() string        { return EventNameOrderDeliveryFailed }
+func (e OrderDeliveryFailed) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderDeliveryFailed) GetOrderStatus() Status { return StatusFailed }
+
+// OrderRedeliveryScheduled means the failed order is returned to dispatch for one more attempt.
+type OrderRedeliveryScheduled struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+	Attempt   int
+}
+
+func NewOrderRedeliveryScheduled(orderID, courierID uuid.UUID, attempt int) OrderRedeliveryScheduled {
+	return OrderRedeliveryScheduled{ID: uuid.New(), OrderID: orderID, CourierID: courierID, Attempt: attempt}
+}
+
+func (e OrderRedeliveryScheduled) GetID() uuid.UUID       { return e.ID }
+func (e OrderRedeliveryScheduled) GetName() string        { return EventNameOrderRedeliveryScheduled }
+func (e OrderRedeliveryScheduled) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderRedeliveryScheduled) GetOrderStatus() Status { return StatusCreated }
+
+type OrderReturnedToSender struct {
+	ID      uuid.UUID
+	OrderID uuid.UUID
+	Reason  FailureReason
+}
+
+func NewOrderReturnedToSender(orderID uuid.UUID, reason FailureReason) OrderReturnedToSender {
+	return OrderReturnedToSender{ID: uuid.New(), OrderID: orderID, Reason: reason}
+}
+
+func (e OrderReturnedToSender) GetID() uuid.UUID       { return e.ID }
+func (e OrderReturnedToSender) GetName() string        { return EventNameOrderReturnedToSender }
+func (e OrderReturnedToSender) GetOrderID() uuid.UUID  { return e.OrderID }
+func (e OrderReturnedToSender) GetOrderStatus() Status { return StatusReturnedToSender }
+
+// OrderSLABreachPredicted does not change the status, so it is not published to order.status.changed.
+type OrderSLABreachPredicted struct {
+	ID         uuid.UUID
+	OrderID    uuid.UUID
+	CourierID  uuid.UUID
+	PromisedBy time.Time
+	ExpectedAt time.Time
+}
+
+func NewOrderSLABreachPredicted(orderID, courierID uuid.UUID, promisedBy, expectedAt time.Time) OrderSLABreachPredicted {
+	return OrderSLABreachPredicted{
+		ID:         uuid.New(),
+		OrderID:    orderID,
+		CourierID:  courierID,
+		PromisedBy: promisedBy,
+		ExpectedAt: expectedAt,
+	}
+}
+
+func (e OrderSLABreachPredicted) GetID() uuid.UUID { return e.ID }
+func (e OrderSLABreachPredicted) GetName() string  { return EventNameOrderSLABreachPredicted }
+
+// Delay is how late the order is expected to be delivered.
+func (e OrderSLABreachPredicted) Delay() time.Duration { return e.ExpectedAt.Sub(e.PromisedBy) }
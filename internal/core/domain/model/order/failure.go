@@ -0,0 +1,58 @@
+package order
+
+import "errors"
+
+const (
+	FailureReasonEmpty           FailureReason = ""
+	FailureReasonCustomerNotHome FailureReason = "CustomerNotHome"
+	FailureReasonAddressNotFound FailureReason = "AddressNotFound"
+	FailureReasonRefused         FailureReason = "Refused"
+	FailureReasonOther           FailureReason = "Other"
+	// FailureReasonNotConfirmed - клиент не назвал верный код за отведенные попытки или время
+	FailureReasonNotConfirmed FailureReason = "NotConfirmed"
+)
+
+var ErrRedeliveryAttemptsExhausted = errors.New("redelivery attempts exhausted")
+
+// FailureReason explains why the courier could not hand the order over.
+type FailureReason string
+
+func (r FailureReason) Equals(other FailureReason) bool {
+	return r == other
+}
+
+func (r FailureReason) IsEmpty() bool {
+	return r == FailureReasonEmpty
+}
+
+func (r FailureReason) IsValid() bool {
+	switch r {
+	case FailureReasonCustomerNotHome, FailureReasonAddressNotFound, FailureReasonRefused, FailureReasonOther,
+		FailureReasonNotConfirmed:
+		return true
+	}
+	return false
+}
+
+func (r FailureReason) String() string {
+	return string(r)
+}
+
+// DeliveryFailure keeps the number of failed delivery attempts and the reason of the last one.
+type DeliveryFailure struct {
+	reason   FailureReason
+	attempts int
+}
+
+func RestoreDeliveryFailure(reason FailureReason, attempts int) DeliveryFailure {
+	return DeliveryFailure{reason: reason, attempts: attempts}
+}
+
+func (f DeliveryFailure) Reason() FailureReason { return f.reason }
+
+func (f DeliveryFailure) Attempts() int { return f.attempts }
+
+// CanRedeliver reports whether one more delivery is allowed after the failed attempts.
+func (f DeliveryFailure) CanRedeliver(maxRedeliveries int) bool {
+	return f.attempts <= maxRedeliveries
+}
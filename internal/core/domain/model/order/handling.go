@@ -0,0 +1,27 @@
+package order
+
+const (
+	HandlingEmpty        Handling = ""
+	HandlingAmbient      Handling = "Ambient"
+	HandlingInsulated    Handling = "Insulated"
+	HandlingRefrigerated Handling = "Refrigerated"
+)
+
+// Handling is the storage the order requires on the way to the customer.
+type Handling string
+
+func (h Handling) Equals(other Handling) bool {
+	return h == other
+}
+
+func (h Handling) IsEmpty() bool {
+	return h == HandlingEmpty
+}
+
+func (h Handling) IsValid() bool {
+	return h == HandlingAmbient || h == HandlingInsulated || h == HandlingRefrigerated
+}
+
+func (h Handling) String() string {
+	return string(h)
+}
@@ -3,6 +3,7 @@ package order
 import (
 	"errors"
 	"slices"
+	"time"
 
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/pkg/ddd"
@@ -11,16 +12,28 @@ import (
 	"github.com/google/uuid"
 )
 
-var ErrOrderNotInitialized = errors.New("order not initialized")
+var (
+	ErrOrderNotInitialized = errors.New("order not initialized")
+	ErrNoDeadline          = errors.New("order has no deadline")
+	ErrCourierNotArrived   = errors.New("courier has not reached the delivery location")
+)
 
 var _ ddd.AggregateRoot = (*Order)(nil)
 
 type Order struct {
 	baseAggregate *ddd.BaseAggregate[uuid.UUID]
 	courierID     *uuid.UUID
+	pickup        kernel.Location
 	location      kernel.Location
 	volume        int
+	weight        int
+	handling      Handling
 	status        Status
+	priority      Priority
+	promisedBy    *time.Time
+	createdAt     time.Time
+	confirmation  Confirmation
+	failure       DeliveryFailure
 }
 
 func NewOrder(orderID uuid.UUID, location kernel.Location, volume int) (*Order, error) {
@@ -34,21 +47,49 @@ func NewOrder(orderID uuid.UUID, location kernel.Location, volume int) (*Order,
 		return nil, errs.NewValueIsRequiredError("volume")
 	}
 
-	return &Order{
+	order := &Order{
 		baseAggregate: ddd.NewBaseAggregate(orderID),
 		location:      location,
 		volume:        volume,
+		handling:      HandlingAmbient,
 		status:        StatusCreated,
-	}, nil
+		priority:      PriorityStandard,
+		createdAt:     time.Now().UTC(),
+	}
+	order.RaiseDomainEvent(NewOrderCreated(orderID))
+
+	return order, nil
 }
 
-func RestoreOrder(id uuid.UUID, courier *uuid.UUID, location kernel.Location, volume int, status Status) *Order {
+func RestoreOrder(
+	id uuid.UUID,
+	courier *uuid.UUID,
+	pickup kernel.Location,
+	location kernel.Location,
+	volume int,
+	weight int,
+	handling Handling,
+	status Status,
+	priority Priority,
+	promisedBy *time.Time,
+	createdAt time.Time,
+	confirmation Confirmation,
+	failure DeliveryFailure,
+) *Order {
 	return &Order{
 		baseAggregate: ddd.NewBaseAggregate(id),
 		courierID:     courier,
+		pickup:        pickup,
 		location:      location,
 		volume:        volume,
+		weight:        weight,
+		handling:      handling,
 		status:        status,
+		priority:      priority,
+		promisedBy:    promisedBy,
+		createdAt:     createdAt,
+		confirmation:  confirmation,
+		failure:       failure,
 	}
 }
 
@@ -74,6 +115,18 @@ func (o *Order) CourierID() *uuid.UUID {
 	return o.courierID
 }
 
+// Pickup is where the courier collects the order, not initialized for orders without a pickup leg.
+func (o *Order) Pickup() kernel.Location {
+	if o == nil {
+		return kernel.Location{}
+	}
+	return o.pickup
+}
+
+func (o *Order) HasPickup() bool {
+	return o.Pickup().IsValid()
+}
+
 func (o *Order) Location() kernel.Location {
 	if o == nil {
 		return kernel.Location{}
@@ -88,6 +141,21 @@ func (o *Order) Volume() int {
 	return o.volume
 }
 
+// Weight is in grams, zero when the weight is not known.
+func (o *Order) Weight() int {
+	if o == nil {
+		return 0
+	}
+	return o.weight
+}
+
+func (o *Order) Handling() Handling {
+	if o == nil {
+		return HandlingEmpty
+	}
+	return o.handling
+}
+
 func (o *Order) Status() Status {
 	if o == nil {
 		return StatusEmpty
@@ -95,6 +163,172 @@ func (o *Order) Status() Status {
 	return o.status
 }
 
+func (o *Order) Priority() Priority {
+	if o == nil {
+		return PriorityEmpty
+	}
+	return o.priority
+}
+
+// PromisedBy is the delivery deadline promised to the customer, nil when there is none.
+func (o *Order) PromisedBy() *time.Time {
+	if o == nil {
+		return nil
+	}
+	return o.promisedBy
+}
+
+func (o *Order) CreatedAt() time.Time {
+	if o == nil {
+		return time.Time{}
+	}
+	return o.createdAt
+}
+
+func (o *Order) Confirmation() Confirmation {
+	if o == nil {
+		return Confirmation{}
+	}
+	return o.confirmation
+}
+
+// Failure is the history of failed delivery attempts, empty until the first failure.
+func (o *Order) Failure() DeliveryFailure {
+	if o == nil {
+		return DeliveryFailure{}
+	}
+	return o.failure
+}
+
+// RequiresConfirmation reports whether the customer has to confirm the delivery with a code.
+func (o *Order) RequiresConfirmation() bool {
+	return o.Confirmation().IsRequired()
+}
+
+// RequireConfirmation issues the code the customer tells the courier on delivery.
+// Only the hash of the code is kept, the code is delivered to the customer by
+// OrderConfirmationCodeIssued.
+func (o *Order) RequireConfirmation() (string, error) {
+	if o == nil {
+		return "", ErrOrderNotInitialized
+	}
+	if !o.status.Equals(StatusCreated) {
+		return "", errs.NewExpectationFailedError("status", o.Status(), StatusCreated)
+	}
+	if o.confirmation.IsRequired() {
+		return "", ErrConfirmationAlreadyRequired
+	}
+
+	code, err := newConfirmationCode()
+	if err != nil {
+		return "", err
+	}
+
+	o.confirmation = Confirmation{codeHash: HashConfirmationCode(o.ID(), code)}
+	o.RaiseDomainEvent(NewOrderConfirmationCodeIssued(o.ID(), code))
+	return code, nil
+}
+
+// SetDeliveryTerms sets the priority and the optional deadline until the order is dispatched.
+func (o *Order) SetDeliveryTerms(priority Priority, promisedBy *time.Time) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !priority.IsValid() {
+		return errs.NewValueIsInvalidError("priority")
+	}
+	if promisedBy != nil && !promisedBy.After(o.createdAt) {
+		return errs.NewValueIsInvalidError("promisedBy")
+	}
+	if !o.status.Equals(StatusCreated) {
+		return errs.NewExpectationFailedError("status", o.Status(), StatusCreated)
+	}
+
+	o.priority = priority
+	o.promisedBy = nil
+	if promisedBy != nil {
+		deadline := promisedBy.UTC()
+		o.promisedBy = &deadline
+	}
+
+	return nil
+}
+
+// SetPickup sets the merchant or warehouse location until the order is dispatched.
+func (o *Order) SetPickup(pickup kernel.Location) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !pickup.IsValid() {
+		return errs.NewValueIsRequiredError("pickup")
+	}
+	if !o.status.Equals(StatusCreated) {
+		return errs.NewExpectationFailedError("status", o.Status(), StatusCreated)
+	}
+
+	o.pickup = pickup
+	return nil
+}
+
+// SetWeight sets the weight in grams until the order is dispatched.
+func (o *Order) SetWeight(weight int) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if weight < 0 {
+		return errs.NewValueIsInvalidError("weight")
+	}
+	if !o.status.Equals(StatusCreated) {
+		return errs.NewExpectationFailedError("status", o.Status(), StatusCreated)
+	}
+
+	o.weight = weight
+	return nil
+}
+
+// SetHandling sets the storage the order requires until the order is dispatched.
+func (o *Order) SetHandling(handling Handling) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !handling.IsValid() {
+		return errs.NewValueIsInvalidError("handling")
+	}
+	if !o.status.Equals(StatusCreated) {
+		return errs.NewExpectationFailedError("status", o.Status(), StatusCreated)
+	}
+
+	o.handling = handling
+	return nil
+}
+
+// WillBreachSLA reports whether delivery at expectedAt misses the promised deadline.
+func (o *Order) WillBreachSLA(expectedAt time.Time) bool {
+	if o == nil || o.promisedBy == nil {
+		return false
+	}
+	return expectedAt.After(*o.promisedBy)
+}
+
+// ReportSLABreach records that the courier is expected to miss the promised deadline.
+func (o *Order) ReportSLABreach(courierID uuid.UUID, expectedAt time.Time) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if courierID == uuid.Nil {
+		return errs.NewValueIsRequiredError("courierID")
+	}
+	if o.promisedBy == nil {
+		return ErrNoDeadline
+	}
+	if !o.WillBreachSLA(expectedAt) {
+		return errs.NewValueIsInvalidError("expectedAt")
+	}
+
+	o.RaiseDomainEvent(NewOrderSLABreachPredicted(o.ID(), courierID, *o.promisedBy, expectedAt))
+	return nil
+}
+
 func (o *Order) Assign(courierID uuid.UUID) error {
 	if o == nil {
 		return ErrOrderNotInitialized
@@ -102,29 +336,292 @@ func (o *Order) Assign(courierID uuid.UUID) error {
 	if courierID == uuid.Nil {
 		return errs.NewValueIsRequiredError("courierID")
 	}
-	// ? no reassign
+	// Переназначение выполняется через Unassign
 	if !o.status.Equals(StatusCreated) {
 		return errs.NewExpectationFailedError("status", o.Status(), StatusCreated)
 	}
 
 	o.courierID = &courierID
 	o.status = StatusAssigned
+	o.RaiseDomainEvent(NewOrderAssigned(o.ID(), courierID))
 
 	return nil
 }
 
-func (o *Order) Complete() error {
+// PickUp records that the courier has collected the order at the pickup location.
+func (o *Order) PickUp() error {
 	if o == nil {
 		return ErrOrderNotInitialized
 	}
+	if !o.HasPickup() {
+		return errs.NewValueIsRequiredError("pickup")
+	}
 	if !o.status.Equals(StatusAssigned) {
 		return errs.NewExpectationFailedError("status", o.Status(), StatusAssigned)
 	}
 
+	o.status = StatusPickedUp
+	o.RaiseDomainEvent(NewOrderPickedUp(o.ID(), o.assignee()))
+
+	return nil
+}
+
+// Complete hands the order over to the customer. Orders with a pickup leg must be picked up
+// first, orders requiring confirmation are completed by Confirm.
+func (o *Order) Complete() error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if err := o.checkReadyToHandOver(); err != nil {
+		return err
+	}
+	if o.confirmation.IsRequired() {
+		return ErrConfirmationRequired
+	}
+
 	o.status = StatusCompleted
+	o.RaiseDomainEvent(NewOrderCompleted(o.ID(), o.assignee(), o.deliveryTime()))
+
+	return nil
+}
+
+// AwaitConfirmation records that the courier has arrived and waits for the customer's code.
+func (o *Order) AwaitConfirmation(arrivedAt time.Time) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !o.confirmation.IsRequired() {
+		return ErrConfirmationNotRequired
+	}
+	if err := o.checkReadyToHandOver(); err != nil {
+		return err
+	}
+
+	arrived := arrivedAt.UTC()
+	o.confirmation.arrivedAt = &arrived
+	o.status = StatusAwaitingConfirmation
+	o.RaiseDomainEvent(NewOrderAwaitingConfirmation(o.ID(), o.assignee()))
+
+	return nil
+}
+
+// Confirm completes the order when the code matches. A wrong code is counted as an attempt
+// and ErrConfirmationCodeMismatch is returned. When no attempts are left the delivery fails
+// with ErrConfirmationAttemptsExceeded and the courier must release the storage place.
+// The order must be saved in both cases.
+func (o *Order) Confirm(code string) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !o.status.Equals(StatusAwaitingConfirmation) {
+		return errs.NewExpectationFailedError("status", o.Status(), StatusAwaitingConfirmation)
+	}
+	if o.confirmation.AttemptsLeft() > 0 && !o.confirmation.matches(o.ID(), code) {
+		o.confirmation.attempts++
+		if o.confirmation.AttemptsLeft() > 0 {
+			return ErrConfirmationCodeMismatch
+		}
+	}
+	if o.confirmation.AttemptsLeft() == 0 {
+		o.fail(FailureReasonNotConfirmed)
+		return ErrConfirmationAttemptsExceeded
+	}
+
+	o.status = StatusCompleted
+	o.RaiseDomainEvent(NewOrderCompleted(o.ID(), o.assignee(), o.deliveryTime()))
+
+	return nil
+}
+
+// IsConfirmationOverdue reports whether the courier has waited for the code longer than timeout.
+func (o *Order) IsConfirmationOverdue(now time.Time, timeout time.Duration) bool {
+	if o == nil || !o.status.Equals(StatusAwaitingConfirmation) || o.confirmation.arrivedAt == nil {
+		return false
+	}
+	return now.Sub(*o.confirmation.arrivedAt) > timeout
+}
+
+// FlagConfirmationOverdue reports the unconfirmed delivery and fails it, so the courier
+// does not wait at the door forever. The courier must release the storage place.
+func (o *Order) FlagConfirmationOverdue(now time.Time, timeout time.Duration) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if o.confirmation.overdue {
+		return ErrConfirmationAlreadyFlagged
+	}
+	if !o.IsConfirmationOverdue(now, timeout) {
+		return ErrConfirmationTimeoutNotReached
+	}
+
+	o.confirmation.overdue = true
+	o.RaiseDomainEvent(NewOrderConfirmationOverdue(o.ID(), o.assignee(), *o.confirmation.arrivedAt))
+
+	o.fail(FailureReasonNotConfirmed)
+	return nil
+}
+
+// checkReadyToHandOver requires the order to be with the courier and collected when it has a pickup.
+func (o *Order) checkReadyToHandOver() error {
+	want := StatusAssigned
+	if o.HasPickup() {
+		want = StatusPickedUp
+	}
+	if !o.status.Equals(want) {
+		return errs.NewExpectationFailedError("status", o.Status(), want)
+	}
+	return nil
+}
+
+// Fail records that the courier could not hand the order over. The courier must be at
+// the delivery location or already waiting for the confirmation code. Assigned courier
+// must release the storage place, the order is then redelivered or returned to sender.
+func (o *Order) Fail(reason FailureReason, courierLocation kernel.Location) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !reason.IsValid() {
+		return errs.NewValueIsInvalidError("reason")
+	}
+	if !o.status.Equals(StatusAwaitingConfirmation) {
+		if err := o.checkReadyToHandOver(); err != nil {
+			return err
+		}
+		if !courierLocation.Equals(o.Location()) {
+			return ErrCourierNotArrived
+		}
+	}
+
+	o.fail(reason)
+	return nil
+}
+
+// fail moves the order to Failed and counts the attempt.
+func (o *Order) fail(reason FailureReason) {
+	o.failure = DeliveryFailure{reason: reason, attempts: o.failure.attempts + 1}
+	o.status = StatusFailed
+	o.RaiseDomainEvent(NewOrderDeliveryFailed(o.ID(), o.assignee(), reason, o.failure.attempts))
+}
+
+// Redeliver returns the failed order to dispatch while the failed attempts do not exceed maxRedeliveries.
+// The order has already been collected, so the next courier takes it without the pickup leg.
+// An order requiring confirmation gets a new code, the customer has new attempts for it.
+func (o *Order) Redeliver(maxRedeliveries int) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !o.status.Equals(StatusFailed) {
+		return errs.NewExpectationFailedError("status", o.Status(), StatusFailed)
+	}
+	if !o.failure.CanRedeliver(maxRedeliveries) {
+		return ErrRedeliveryAttemptsExhausted
+	}
+
+	var code string
+	if o.confirmation.IsRequired() {
+		var err error
+		if code, err = newConfirmationCode(); err != nil {
+			return err
+		}
+	}
+
+	courierID := o.assignee()
+	o.courierID = nil
+	o.pickup = kernel.Location{}
+	o.status = StatusCreated
+	o.RaiseDomainEvent(NewOrderRedeliveryScheduled(o.ID(), courierID, o.failure.attempts))
+	if code != "" {
+		o.confirmation = Confirmation{codeHash: HashConfirmationCode(o.ID(), code)}
+		o.RaiseDomainEvent(NewOrderConfirmationCodeIssued(o.ID(), code))
+	}
+
 	return nil
 }
 
+// ReturnToSender is the final outcome of a failed order which will not be redelivered.
+func (o *Order) ReturnToSender() error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !o.status.Equals(StatusFailed) {
+		return errs.NewExpectationFailedError("status", o.Status(), StatusFailed)
+	}
+
+	o.status = StatusReturnedToSender
+	o.RaiseDomainEvent(NewOrderReturnedToSender(o.ID(), o.failure.reason))
+
+	return nil
+}
+
+// LeaveAt hands the picked up order back at the courier's location, so the next courier
+// collects it there. The order is then unassigned or reassigned, see services.OrderReassigner.
+func (o *Order) LeaveAt(location kernel.Location) error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !location.IsValid() {
+		return errs.NewValueIsRequiredError("location")
+	}
+	if !o.status.Equals(StatusPickedUp) {
+		return errs.NewExpectationFailedError("status", o.Status(), StatusPickedUp)
+	}
+
+	o.pickup = location
+	o.status = StatusAssigned
+
+	return nil
+}
+
+// Unassign returns the assigned order to the dispatch queue. Assigned courier
+// must release the storage place, see services.OrderReassigner.
+func (o *Order) Unassign() error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !o.status.Equals(StatusAssigned) {
+		return errs.NewExpectationFailedError("status", o.Status(), StatusAssigned)
+	}
+
+	courierID := o.assignee()
+	o.courierID = nil
+	o.status = StatusCreated
+	o.RaiseDomainEvent(NewOrderUnassigned(o.ID(), courierID))
+
+	return nil
+}
+
+// Cancel is allowed until the order is completed. Assigned courier must
+// release the storage place, see services.OrderCanceller.
+func (o *Order) Cancel() error {
+	if o == nil {
+		return ErrOrderNotInitialized
+	}
+	if !o.status.Equals(StatusCreated) && !o.status.Equals(StatusFailed) && !o.status.IsWithCourier() {
+		return errs.NewExpectationFailedError("status", o.Status(),
+			StatusCreated, StatusAssigned, StatusPickedUp, StatusAwaitingConfirmation, StatusFailed)
+	}
+
+	o.status = StatusCancelled
+	o.RaiseDomainEvent(NewOrderCancelled(o.ID(), o.courierID))
+
+	return nil
+}
+
+// deliveryTime is the time passed since the order was created, zero for orders without creation time.
+func (o *Order) deliveryTime() time.Duration {
+	if o.createdAt.IsZero() {
+		return 0
+	}
+	return max(time.Now().UTC().Sub(o.createdAt), 0)
+}
+
+func (o *Order) assignee() uuid.UUID {
+	if o.courierID == nil {
+		return uuid.Nil
+	}
+	return *o.courierID
+}
+
 func (o *Order) ClearDomainEvents() {
 	o.baseAggregate.ClearDomainEvents()
 }
@@ -1,7 +1,9 @@
 package order_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"delivery/internal/core/domain/model/kernel"
 	. "delivery/internal/core/domain/model/order"
@@ -193,3 +195,499 @@ func TestOrder_Complete(t *testing.T) {
 		})
 	}
 }
+
+func TestOrder_DomainEvents(t *testing.T) {
+	assert := assert.New(t)
+
+	orderID := uuid.New()
+	courierID := uuid.New()
+
+	order, err := NewOrder(orderID, kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(order.Assign(courierID))
+	assert.NoError(order.Complete())
+
+	events := order.GetDomainEvents()
+	assert.Len(events, 3)
+
+	created, ok := events[0].(OrderCreated)
+	assert.True(ok)
+	assert.NotEqual(uuid.Nil, created.GetID())
+	assert.Equal(orderID, created.OrderID)
+	assert.Equal(StatusCreated, created.GetOrderStatus())
+
+	assigned, ok := events[1].(OrderAssigned)
+	assert.True(ok)
+	assert.Equal(orderID, assigned.OrderID)
+	assert.Equal(courierID, assigned.CourierID)
+	assert.Equal(StatusAssigned, assigned.GetOrderStatus())
+
+	completed, ok := events[2].(OrderCompleted)
+	assert.True(ok)
+	assert.Equal(orderID, completed.OrderID)
+	assert.Equal(courierID, completed.CourierID)
+	assert.Equal(StatusCompleted, completed.GetOrderStatus())
+	assert.Less(completed.DeliveryTime, time.Minute)
+
+	order.ClearDomainEvents()
+	assert.Empty(order.GetDomainEvents())
+}
+
+func TestOrder_DomainEventsNotRaisedOnFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	order, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	order.ClearDomainEvents()
+
+	assert.Error(order.Complete())
+	assert.Error(order.Assign(uuid.Nil))
+	assert.Empty(order.GetDomainEvents())
+}
+
+func TestRestoreOrder_DoesNotRaiseDomainEvents(t *testing.T) {
+	order := RestoreOrder(uuid.New(), nil, kernel.Location{}, kernel.NewRandomLocation(), 1, 0, HandlingAmbient, StatusCreated, PriorityStandard, nil, time.Now(), Confirmation{}, DeliveryFailure{})
+	assert.Empty(t, order.GetDomainEvents())
+}
+
+func TestOrder_Cancel(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		name  string
+		order *Order
+		want  error
+	}{
+		{
+			name: "good created",
+			order: func() *Order {
+				order, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+				assert.NoError(err)
+				return order
+			}(),
+			want: nil,
+		},
+		{
+			name: "good assigned",
+			order: func() *Order {
+				order, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+				assert.NoError(err)
+				assert.NoError(order.Assign(uuid.New()))
+				return order
+			}(),
+			want: nil,
+		},
+		{
+			name: "bad completed",
+			order: func() *Order {
+				order, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+				assert.NoError(err)
+				assert.NoError(order.Assign(uuid.New()))
+				assert.NoError(order.Complete())
+				return order
+			}(),
+			want: errs.ErrExpectationFailed,
+		},
+		{
+			name: "bad cancelled",
+			order: func() *Order {
+				order, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+				assert.NoError(err)
+				assert.NoError(order.Cancel())
+				return order
+			}(),
+			want: errs.ErrExpectationFailed,
+		},
+		{
+			name: "bad nil order",
+			want: ErrOrderNotInitialized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.order.Cancel(); err != nil {
+				assert.ErrorIs(err, tt.want)
+			} else {
+				assert.Equal(StatusCancelled, tt.order.Status())
+				events := tt.order.GetDomainEvents()
+				cancelled, ok := events[len(events)-1].(OrderCancelled)
+				assert.True(ok)
+				assert.Equal(tt.order.ID(), cancelled.OrderID)
+				assert.Equal(tt.order.CourierID(), cancelled.CourierID)
+			}
+		})
+	}
+}
+
+func TestOrder_Unassign(t *testing.T) {
+	assert := assert.New(t)
+
+	courierID := uuid.New()
+	order, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+
+	// Не назначенный заказ
+	assert.ErrorIs(order.Unassign(), errs.ErrExpectationFailed)
+
+	assert.NoError(order.Assign(courierID))
+	assert.NoError(order.Unassign())
+	assert.Equal(StatusCreated, order.Status())
+	assert.Nil(order.CourierID())
+
+	events := order.GetDomainEvents()
+	unassigned, ok := events[len(events)-1].(OrderUnassigned)
+	assert.True(ok)
+	assert.Equal(courierID, unassigned.CourierID)
+	assert.Equal(StatusCreated, unassigned.GetOrderStatus())
+
+	// Можно назначить другому курьеру
+	assert.NoError(order.Assign(uuid.New()))
+
+	var nilOrder *Order
+	assert.ErrorIs(nilOrder.Unassign(), ErrOrderNotInitialized)
+}
+
+func TestOrder_PickUp(t *testing.T) {
+	assert := assert.New(t)
+
+	courierID := uuid.New()
+	pickup, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	dropoff, err := kernel.NewLocation(5, 5)
+	assert.NoError(err)
+
+	order, err := NewOrder(uuid.New(), dropoff, 1)
+	assert.NoError(err)
+	assert.False(order.HasPickup())
+	assert.ErrorIs(order.SetPickup(kernel.Location{}), errs.ErrValueIsRequired)
+	assert.NoError(order.SetPickup(pickup))
+	assert.True(order.HasPickup())
+	assert.True(pickup.Equals(order.Pickup()))
+
+	// Забрать можно только назначенный заказ
+	assert.ErrorIs(order.PickUp(), errs.ErrExpectationFailed)
+	assert.NoError(order.Assign(courierID))
+	assert.ErrorIs(order.SetPickup(dropoff), errs.ErrExpectationFailed)
+
+	// Нельзя отдать клиенту, не забрав
+	assert.ErrorIs(order.Complete(), errs.ErrExpectationFailed)
+
+	order.ClearDomainEvents()
+	assert.NoError(order.PickUp())
+	assert.Equal(StatusPickedUp, order.Status())
+	pickedUp, ok := order.GetDomainEvents()[0].(OrderPickedUp)
+	assert.True(ok)
+	assert.Equal(order.ID(), pickedUp.OrderID)
+	assert.Equal(courierID, pickedUp.CourierID)
+	assert.Equal(StatusPickedUp, pickedUp.GetOrderStatus())
+
+	// Забранный заказ нельзя вернуть в очередь без LeaveAt, но можно отменить
+	assert.ErrorIs(order.Unassign(), errs.ErrExpectationFailed)
+	assert.ErrorIs(order.PickUp(), errs.ErrExpectationFailed)
+	assert.NoError(order.Complete())
+	assert.Equal(StatusCompleted, order.Status())
+
+	cancelled, err := NewOrder(uuid.New(), dropoff, 1)
+	assert.NoError(err)
+	assert.NoError(cancelled.SetPickup(pickup))
+	assert.NoError(cancelled.Assign(courierID))
+	assert.NoError(cancelled.PickUp())
+	assert.NoError(cancelled.Cancel())
+
+	// Заказ без точки выдачи отдается сразу после назначения
+	direct, err := NewOrder(uuid.New(), dropoff, 1)
+	assert.NoError(err)
+	assert.NoError(direct.Assign(courierID))
+	assert.ErrorIs(direct.PickUp(), errs.ErrValueIsRequired)
+	assert.NoError(direct.Complete())
+
+	var nilOrder *Order
+	assert.ErrorIs(nilOrder.PickUp(), ErrOrderNotInitialized)
+}
+
+func TestOrder_LeaveAt(t *testing.T) {
+	assert := assert.New(t)
+
+	pickup, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	courierLocation, err := kernel.NewLocation(3, 3)
+	assert.NoError(err)
+	order, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.NoError(order.SetPickup(pickup))
+	assert.NoError(order.Assign(uuid.New()))
+
+	// Оставить можно только забранный заказ
+	assert.ErrorIs(order.LeaveAt(courierLocation), errs.ErrExpectationFailed)
+	assert.NoError(order.PickUp())
+	assert.ErrorIs(order.LeaveAt(kernel.Location{}), errs.ErrValueIsRequired)
+
+	// Следующий курьер забирает заказ там, где его оставили
+	assert.NoError(order.LeaveAt(courierLocation))
+	assert.Equal(StatusAssigned, order.Status())
+	assert.True(courierLocation.Equals(order.Pickup()))
+	assert.NoError(order.Unassign())
+	assert.NoError(order.Assign(uuid.New()))
+	assert.NoError(order.PickUp())
+
+	var nilOrder *Order
+	assert.ErrorIs(nilOrder.LeaveAt(courierLocation), ErrOrderNotInitialized)
+}
+
+func TestOrder_Confirmation(t *testing.T) {
+	assert := assert.New(t)
+
+	courierID := uuid.New()
+	location, err := kernel.NewLocation(5, 5)
+	assert.NoError(err)
+
+	order, err := NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	assert.False(order.RequiresConfirmation())
+	assert.ErrorIs(order.AwaitConfirmation(time.Now()), ErrConfirmationNotRequired)
+
+	order.ClearDomainEvents()
+	code, err := order.RequireConfirmation()
+	assert.NoError(err)
+	assert.Len(code, 4)
+	assert.True(order.RequiresConfirmation())
+	issued, ok := order.GetDomainEvents()[0].(OrderConfirmationCodeIssued)
+	assert.True(ok)
+	assert.Equal(code, issued.Code)
+	_, err = order.RequireConfirmation()
+	assert.ErrorIs(err, ErrConfirmationAlreadyRequired)
+
+	// Без кода заказ завершить нельзя
+	assert.NoError(order.Assign(courierID))
+	assert.ErrorIs(order.Complete(), ErrConfirmationRequired)
+	assert.ErrorIs(order.Confirm(code), errs.ErrExpectationFailed)
+
+	arrivedAt := time.Now().UTC()
+	assert.NoError(order.AwaitConfirmation(arrivedAt))
+	assert.Equal(StatusAwaitingConfirmation, order.Status())
+	assert.True(order.Status().IsWithCourier())
+
+	// Неверный код расходует попытку
+	assert.ErrorIs(order.Confirm(code+"0"), ErrConfirmationCodeMismatch)
+	assert.Equal(1, order.Confirmation().Attempts())
+	assert.Equal(MaxConfirmationAttempts-1, order.Confirmation().AttemptsLeft())
+
+	assert.NoError(order.Confirm(code))
+	assert.Equal(StatusCompleted, order.Status())
+
+	// Исчерпание попыток означает неудачную доставку, курьер освобождается
+	locked, err := NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	lockedCode, err := locked.RequireConfirmation()
+	assert.NoError(err)
+	assert.NoError(locked.Assign(courierID))
+	assert.NoError(locked.AwaitConfirmation(arrivedAt))
+	for range MaxConfirmationAttempts - 1 {
+		assert.ErrorIs(locked.Confirm("wrong"), ErrConfirmationCodeMismatch)
+	}
+	locked.ClearDomainEvents()
+	assert.ErrorIs(locked.Confirm("wrong"), ErrConfirmationAttemptsExceeded)
+	assert.Equal(StatusFailed, locked.Status())
+	assert.Equal(FailureReasonNotConfirmed, locked.Failure().Reason())
+	failed, ok := locked.GetDomainEvents()[0].(OrderDeliveryFailed)
+	assert.True(ok)
+	assert.Equal(courierID, failed.CourierID)
+	assert.ErrorIs(locked.Confirm(lockedCode), errs.ErrExpectationFailed)
+
+	// Просроченное ожидание фиксируется один раз и тоже завершается неудачей
+	waiting, err := NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	_, err = waiting.RequireConfirmation()
+	assert.NoError(err)
+	assert.NoError(waiting.Assign(courierID))
+	assert.NoError(waiting.AwaitConfirmation(arrivedAt))
+	timeout := 5 * time.Minute
+	assert.ErrorIs(waiting.FlagConfirmationOverdue(arrivedAt.Add(time.Minute), timeout), ErrConfirmationTimeoutNotReached)
+	waiting.ClearDomainEvents()
+	assert.NoError(waiting.FlagConfirmationOverdue(arrivedAt.Add(10*time.Minute), timeout))
+	overdue, ok := waiting.GetDomainEvents()[0].(OrderConfirmationOverdue)
+	assert.True(ok)
+	assert.Equal(courierID, overdue.CourierID)
+	assert.Equal(StatusFailed, waiting.Status())
+	assert.Equal(FailureReasonNotConfirmed, waiting.Failure().Reason())
+	assert.ErrorIs(waiting.FlagConfirmationOverdue(arrivedAt.Add(10*time.Minute), timeout), ErrConfirmationAlreadyFlagged)
+}
+
+func TestOrder_ConfirmationCodeIsNotStored(t *testing.T) {
+	assert := assert.New(t)
+
+	location, err := kernel.NewLocation(5, 5)
+	assert.NoError(err)
+	order, err := NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	order.ClearDomainEvents()
+	code, err := order.RequireConfirmation()
+	assert.NoError(err)
+
+	// Заказ хранит только хеш кода, соль - идентификатор заказа
+	assert.Equal(HashConfirmationCode(order.ID(), code), order.Confirmation().CodeHash())
+	assert.NotEqual(HashConfirmationCode(uuid.New(), code), order.Confirmation().CodeHash())
+
+	// Код не попадает в сериализованное событие и, значит, в outbox
+	payload, err := json.Marshal(order.GetDomainEvents()[0])
+	assert.NoError(err)
+	var fields map[string]any
+	assert.NoError(json.Unmarshal(payload, &fields))
+	assert.NotContains(fields, "Code")
+	assert.Equal(order.ID().String(), fields["OrderID"])
+}
+
+func TestOrder_FailAndRedeliver(t *testing.T) {
+	assert := assert.New(t)
+
+	courierID := uuid.New()
+	location, err := kernel.NewLocation(5, 5)
+	assert.NoError(err)
+
+	order, err := NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+
+	// Неназначенный заказ не может быть не вручен
+	assert.ErrorIs(order.Fail(FailureReasonCustomerNotHome, location), errs.ErrExpectationFailed)
+	assert.NoError(order.Assign(courierID))
+	assert.ErrorIs(order.Fail(FailureReasonEmpty, location), errs.ErrValueIsInvalid)
+	assert.ErrorIs(order.Redeliver(1), errs.ErrExpectationFailed)
+
+	// Курьер ещё в пути, попытка доставки не тратится
+	road, err := kernel.NewLocation(4, 5)
+	assert.NoError(err)
+	assert.ErrorIs(order.Fail(FailureReasonCustomerNotHome, road), ErrCourierNotArrived)
+	assert.Equal(StatusAssigned, order.Status())
+	assert.Equal(0, order.Failure().Attempts())
+
+	order.ClearDomainEvents()
+	assert.NoError(order.Fail(FailureReasonCustomerNotHome, location))
+	assert.Equal(StatusFailed, order.Status())
+	assert.Equal(FailureReasonCustomerNotHome, order.Failure().Reason())
+	assert.Equal(1, order.Failure().Attempts())
+	failed, ok := order.GetDomainEvents()[0].(OrderDeliveryFailed)
+	assert.True(ok)
+	assert.Equal(courierID, failed.CourierID)
+	assert.Equal(StatusFailed, failed.GetOrderStatus())
+
+	// Первая повторная доставка
+	assert.NoError(order.Redeliver(1))
+	assert.Equal(StatusCreated, order.Status())
+	assert.Nil(order.CourierID())
+
+	// Вторая неудача исчерпывает единственную повторную попытку
+	assert.NoError(order.Assign(courierID))
+	assert.NoError(order.Fail(FailureReasonAddressNotFound, location))
+	assert.Equal(2, order.Failure().Attempts())
+	assert.False(order.Failure().CanRedeliver(1))
+	assert.ErrorIs(order.Redeliver(1), ErrRedeliveryAttemptsExhausted)
+
+	order.ClearDomainEvents()
+	assert.NoError(order.ReturnToSender())
+	assert.Equal(StatusReturnedToSender, order.Status())
+	returned, ok := order.GetDomainEvents()[0].(OrderReturnedToSender)
+	assert.True(ok)
+	assert.Equal(FailureReasonAddressNotFound, returned.Reason)
+	assert.ErrorIs(order.ReturnToSender(), errs.ErrExpectationFailed)
+	assert.ErrorIs(order.Cancel(), errs.ErrExpectationFailed)
+
+	// Курьер, ожидающий код, тоже может отметить неудачу. При повторной доставке клиент
+	// получает новый код, подобранные попытки к старому коду не помогут
+	awaiting, err := NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	code, err := awaiting.RequireConfirmation()
+	assert.NoError(err)
+	assert.NoError(awaiting.Assign(courierID))
+	assert.NoError(awaiting.AwaitConfirmation(time.Now()))
+	assert.ErrorIs(awaiting.Confirm("wrong"), ErrConfirmationCodeMismatch)
+	assert.NoError(awaiting.Fail(FailureReasonRefused, location))
+	awaiting.ClearDomainEvents()
+	assert.NoError(awaiting.Redeliver(1))
+	assert.NotEqual(HashConfirmationCode(awaiting.ID(), code), awaiting.Confirmation().CodeHash())
+	assert.Equal(0, awaiting.Confirmation().Attempts())
+	assert.Nil(awaiting.Confirmation().ArrivedAt())
+	issued, ok := awaiting.GetDomainEvents()[1].(OrderConfirmationCodeIssued)
+	assert.True(ok)
+	assert.Equal(HashConfirmationCode(awaiting.ID(), issued.Code), awaiting.Confirmation().CodeHash())
+
+	// Забранный заказ повторно доставляется без поездки в точку выдачи
+	collected, err := NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	pickup, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	assert.NoError(collected.SetPickup(pickup))
+	assert.NoError(collected.Assign(courierID))
+	assert.ErrorIs(collected.Fail(FailureReasonCustomerNotHome, location), errs.ErrExpectationFailed)
+	assert.NoError(collected.PickUp())
+	assert.NoError(collected.Fail(FailureReasonCustomerNotHome, location))
+	assert.NoError(collected.Redeliver(1))
+	assert.False(collected.HasPickup())
+	assert.NoError(collected.Assign(courierID))
+	assert.NoError(collected.Complete())
+
+	var nilOrder *Order
+	assert.ErrorIs(nilOrder.Fail(FailureReasonOther, location), ErrOrderNotInitialized)
+}
+
+func TestOrder_SetWeight(t *testing.T) {
+	assert := assert.New(t)
+
+	order, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.Equal(0, order.Weight())
+
+	assert.ErrorIs(order.SetWeight(-1), errs.ErrValueIsInvalid)
+	assert.NoError(order.SetWeight(1500))
+	assert.Equal(1500, order.Weight())
+
+	// Вес фиксируется при назначении
+	assert.NoError(order.Assign(uuid.New()))
+	assert.ErrorIs(order.SetWeight(100), errs.ErrExpectationFailed)
+}
+
+func TestOrder_DeliveryTerms(t *testing.T) {
+	assert := assert.New(t)
+
+	order, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.Equal(PriorityStandard, order.Priority())
+	assert.Nil(order.PromisedBy())
+	assert.False(order.CreatedAt().IsZero())
+	assert.False(order.WillBreachSLA(time.Now().Add(24 * time.Hour)))
+
+	// Невалидные условия
+	assert.ErrorIs(order.SetDeliveryTerms(Priority("Urgent"), nil), errs.ErrValueIsInvalid)
+	past := order.CreatedAt().Add(-time.Minute)
+	assert.ErrorIs(order.SetDeliveryTerms(PriorityExpress, &past), errs.ErrValueIsInvalid)
+
+	deadline := order.CreatedAt().Add(time.Hour)
+	assert.NoError(order.SetDeliveryTerms(PriorityExpress, &deadline))
+	assert.Equal(PriorityExpress, order.Priority())
+	assert.True(deadline.Equal(*order.PromisedBy()))
+
+	assert.False(order.WillBreachSLA(deadline))
+	assert.True(order.WillBreachSLA(deadline.Add(time.Second)))
+
+	// После назначения условия не меняются
+	courierID := uuid.New()
+	assert.NoError(order.Assign(courierID))
+	assert.ErrorIs(order.SetDeliveryTerms(PriorityStandard, nil), errs.ErrExpectationFailed)
+
+	// Нарушение SLA фиксируется событием
+	order.ClearDomainEvents()
+	assert.ErrorIs(order.ReportSLABreach(courierID, deadline), errs.ErrValueIsInvalid)
+	expectedAt := deadline.Add(time.Minute)
+	assert.NoError(order.ReportSLABreach(courierID, expectedAt))
+	assert.Len(order.GetDomainEvents(), 1)
+	event, ok := order.GetDomainEvents()[0].(OrderSLABreachPredicted)
+	assert.True(ok)
+	assert.Equal(order.ID(), event.OrderID)
+	assert.Equal(courierID, event.CourierID)
+	assert.Equal(time.Minute, event.Delay())
+
+	// Без срока сообщать не о чем
+	standard, err := NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+	assert.NoError(err)
+	assert.ErrorIs(standard.ReportSLABreach(courierID, expectedAt), ErrNoDeadline)
+}
@@ -0,0 +1,25 @@
+package order
+
+const (
+	PriorityEmpty    Priority = ""
+	PriorityStandard Priority = "Standard"
+	PriorityExpress  Priority = "Express"
+)
+
+type Priority string
+
+func (p Priority) Equals(other Priority) bool {
+	return p == other
+}
+
+func (p Priority) IsEmpty() bool {
+	return p == PriorityEmpty
+}
+
+func (p Priority) IsValid() bool {
+	return p == PriorityStandard || p == PriorityExpress
+}
+
+func (p Priority) String() string {
+	return string(p)
+}
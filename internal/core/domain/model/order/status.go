@@ -1,10 +1,18 @@
 package order
 
 const (
-	StatusEmpty     Status = ""
-	StatusCreated   Status = "Created"
-	StatusAssigned  Status = "Assigned"
-	StatusCompleted Status = "Completed"
+	StatusEmpty    Status = ""
+	StatusCreated  Status = "Created"
+	StatusAssigned Status = "Assigned"
+	StatusPickedUp Status = "PickedUp"
+	// StatusAwaitingConfirmation - курьер у клиента и ждет код подтверждения
+	StatusAwaitingConfirmation Status = "AwaitingConfirmation"
+	StatusCompleted            Status = "Completed"
+	StatusCancelled            Status = "Cancelled"
+	// StatusFailed - курьер не смог вручить заказ, решается повторная доставка или возврат
+	StatusFailed Status = "Failed"
+	// StatusReturnedToSender - попытки доставки исчерпаны, заказ возвращается отправителю
+	StatusReturnedToSender Status = "ReturnedToSender"
 )
 
 type Status string
@@ -13,6 +21,11 @@ func (s Status) Equals(other Status) bool {
 	return s == other
 }
 
+// IsWithCourier reports whether the order is held by the assigned courier.
+func (s Status) IsWithCourier() bool {
+	return s == StatusAssigned || s == StatusPickedUp || s == StatusAwaitingConfirmation
+}
+
 func (s Status) IsEmpty() bool {
 	return s == StatusEmpty
 }
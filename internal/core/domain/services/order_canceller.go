@@ -0,0 +1,38 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+)
+
+type OrderCanceller interface {
+	// Cancel cancels the order, the courier is required only for an assigned order.
+	Cancel(*order.Order, *courier.Courier) error
+}
+
+var _ OrderCanceller = (*orderCanceller)(nil)
+
+type orderCanceller struct{}
+
+func NewOrderCanceller() OrderCanceller { return new(orderCanceller) }
+
+func (s *orderCanceller) Cancel(ordering *order.Order, assignee *courier.Courier) error {
+	if ordering == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
+
+	if ordering.Status().IsWithCourier() {
+		if assignee == nil {
+			return errs.NewValueIsRequiredError("courier")
+		}
+		if ordering.CourierID() == nil || *ordering.CourierID() != assignee.ID() {
+			return errs.NewExpectationFailedError("courier", assignee.ID(), ordering.CourierID())
+		}
+		if err := assignee.ReleaseOrder(ordering); err != nil {
+			return err
+		}
+	}
+
+	return ordering.Cancel()
+}
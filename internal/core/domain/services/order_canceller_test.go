@@ -0,0 +1,80 @@
+package services_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_orderCanceller_Cancel(t *testing.T) {
+	assert := assert.New(t)
+	canceller := services.NewOrderCanceller()
+
+	newCourier := func() *courier.Courier {
+		cur, err := courier.NewCourier("test", courier.TransportTypePedestrian, 1, kernel.NewRandomLocation())
+		assert.NoError(err)
+		return cur
+	}
+	newOrder := func() *order.Order {
+		o, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+		assert.NoError(err)
+		return o
+	}
+	assign := func(o *order.Order, c *courier.Courier) {
+		assert.NoError(c.TakeOrder(o))
+		assert.NoError(o.Assign(c.ID()))
+	}
+
+	t.Run("good created", func(t *testing.T) {
+		o := newOrder()
+		assert.NoError(canceller.Cancel(o, nil))
+		assert.Equal(order.StatusCancelled, o.Status())
+	})
+
+	t.Run("good assigned releases storage place", func(t *testing.T) {
+		o, c := newOrder(), newCourier()
+		assign(o, c)
+
+		assert.NoError(canceller.Cancel(o, c))
+		assert.Equal(order.StatusCancelled, o.Status())
+		for _, sp := range c.StoragePlaces() {
+			assert.False(sp.IsOccupied())
+		}
+	})
+
+	t.Run("bad assigned without courier", func(t *testing.T) {
+		o, c := newOrder(), newCourier()
+		assign(o, c)
+
+		assert.ErrorIs(canceller.Cancel(o, nil), errs.ErrValueIsRequired)
+		assert.Equal(order.StatusAssigned, o.Status())
+	})
+
+	t.Run("bad assigned to other courier", func(t *testing.T) {
+		o, c := newOrder(), newCourier()
+		assign(o, c)
+
+		assert.ErrorIs(canceller.Cancel(o, newCourier()), errs.ErrExpectationFailed)
+		assert.Equal(order.StatusAssigned, o.Status())
+	})
+
+	t.Run("bad completed", func(t *testing.T) {
+		o, c := newOrder(), newCourier()
+		assign(o, c)
+		assert.NoError(c.CompleteOrder(o))
+		assert.NoError(o.Complete())
+
+		assert.ErrorIs(canceller.Cancel(o, c), errs.ErrExpectationFailed)
+	})
+
+	t.Run("bad nil order", func(t *testing.T) {
+		assert.ErrorIs(canceller.Cancel(nil, nil), errs.ErrValueIsRequired)
+	})
+}
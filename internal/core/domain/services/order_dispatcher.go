@@ -3,14 +3,20 @@ package services
 import (
 	"errors"
 	"math"
+	"time"
 
 	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
 )
 
+// OrderDispatcher picks the courier for the order. Stats of the couriers are optional
+// and only break ties between couriers which deliver equally fast.
 type OrderDispatcher interface {
-	Dispatch(*order.Order, []*courier.Courier) (*courier.Courier, error)
+	Dispatch(*order.Order, []*courier.Courier, map[uuid.UUID]courier.Stats) (*courier.Courier, error)
 }
 
 var (
@@ -18,13 +24,30 @@ var (
 	ErrNoRightCourier  = errors.New("no right courier")
 )
 
+// DefaultMoveStep is how often couriers make a step, see MoveCouriersCommand.
+const DefaultMoveStep = time.Second
+
 var _ OrderDispatcher = (*orderDispatcher)(nil)
 
-type orderDispatcher struct{}
+type orderDispatcher struct {
+	area    kernel.Area
+	planner RoutePlanner
+	now     func() time.Time
+	step    time.Duration
+}
 
-func NewOrderDispatcher() OrderDispatcher { return new(orderDispatcher) }
+// NewOrderDispatcher makes a dispatcher which measures distances in the delivery area.
+func NewOrderDispatcher(area kernel.Area) OrderDispatcher {
+	return NewOrderDispatcherWithClock(area, time.Now, DefaultMoveStep)
+}
 
-func (o *orderDispatcher) Dispatch(ordering *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
+// NewOrderDispatcherWithClock makes a dispatcher which estimates arrival with the given
+// clock and duration of a courier step.
+func NewOrderDispatcherWithClock(area kernel.Area, now func() time.Time, step time.Duration) OrderDispatcher {
+	return &orderDispatcher{area: area, planner: NewRoutePlanner(area), now: now, step: step}
+}
+
+func (o *orderDispatcher) Dispatch(ordering *order.Order, couriers []*courier.Courier, stats map[uuid.UUID]courier.Stats) (*courier.Courier, error) {
 	if ordering == nil {
 		return nil, errors.Join(ErrCantAssignOrder, errs.NewValueIsRequiredError("order"))
 	}
@@ -33,13 +56,26 @@ func (o *orderDispatcher) Dispatch(ordering *order.Order, couriers []*courier.Co
 		return nil, errors.Join(ErrCantAssignOrder, errs.NewExpectationFailedError("ordering.status", ordering.Status(), order.StatusCreated))
 	}
 
-	index, remain := -1, math.MaxFloat64
+	// Рассматриваем только курьеров с подходящим местом хранения (объем, вес, температура).
+	// Предпочитаем курьеров, успевающих к обещанному сроку, среди них - самого быстрого.
+	// Время считаем по маршруту курьера с добавленным заказом: сначала он развозит то, что
+	// по пути, поэтому загруженный курьер медленнее свободного. При равенстве решает статистика курьера
+	now := o.now()
+	index, remain, onTime := -1, math.MaxFloat64, false
 	for i := range couriers {
+		if couriers[i] == nil || !couriers[i].IsOnline() {
+			continue
+		}
 		ok, err := couriers[i].CanTakeOrder(ordering)
 		if ok && err == nil {
-			dt, err := couriers[i].CalculateTimeToLocation(ordering.Location())
-			if dt < remain && err == nil {
-				index, remain = i, dt
+			dt, err := o.timeToDeliver(couriers[i], ordering)
+			if err != nil {
+				continue
+			}
+			inTime := !ordering.WillBreachSLA(o.expectedAt(now, dt))
+			if (inTime && !onTime) || (inTime == onTime && dt < remain) ||
+				(index >= 0 && inTime == onTime && dt == remain && o.isBetter(stats, couriers[i], couriers[index])) {
+				index, remain, onTime = i, dt, inTime
 			}
 		}
 	}
@@ -55,8 +91,61 @@ func (o *orderDispatcher) Dispatch(ordering *order.Order, couriers []*courier.Co
 			return nil, errors.Join(ErrCantAssignOrder, err)
 		}
 
+		if !onTime {
+			if err = ordering.ReportSLABreach(courier.ID(), o.expectedAt(now, remain)); err != nil {
+				return nil, errors.Join(ErrCantAssignOrder, err)
+			}
+		}
+
 		return couriers[index], nil
 	}
 
 	return nil, errors.Join(ErrCantAssignOrder, ErrNoRightCourier)
 }
+
+// timeToDeliver estimates the steps until the courier hands the order over,
+// following the planned route with the stops of the order added.
+func (o *orderDispatcher) timeToDeliver(cur *courier.Courier, ordering *order.Order) (float64, error) {
+	stops := cur.Route()
+	if ordering.HasPickup() {
+		stop, err := courier.NewPickupStop(ordering.ID(), ordering.Pickup())
+		if err != nil {
+			return 0, err
+		}
+		stops = append(stops, stop)
+	}
+	dropoff, err := courier.NewRouteStop(ordering.ID(), ordering.Location())
+	if err != nil {
+		return 0, err
+	}
+	stops = append(stops, dropoff)
+
+	route, err := o.planner.Plan(cur.Location(), stops)
+	if err != nil {
+		return 0, err
+	}
+
+	distance, current := 0, cur.Location()
+	for _, stop := range route {
+		step, err := o.area.Distance(current, stop.Location())
+		if err != nil {
+			return 0, err
+		}
+		distance += step
+		current = stop.Location()
+		if stop.OrderID() == ordering.ID() && !stop.IsPickup() {
+			break
+		}
+	}
+	return float64(distance) / float64(cur.Speed()), nil
+}
+
+// isBetter breaks a tie between two couriers by their stats.
+func (o *orderDispatcher) isBetter(stats map[uuid.UUID]courier.Stats, candidate, current *courier.Courier) bool {
+	return stats[candidate.ID()].IsBetterThan(stats[current.ID()])
+}
+
+// expectedAt converts the number of steps to the moment of arrival, a started step counts as a whole.
+func (o *orderDispatcher) expectedAt(now time.Time, steps float64) time.Time {
+	return now.Add(time.Duration(math.Ceil(steps)) * o.step)
+}
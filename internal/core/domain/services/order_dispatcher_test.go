@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"testing"
+	"time"
 
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
@@ -16,13 +17,13 @@ import (
 func Test_orderDispatcher_Dispatch(t *testing.T) {
 	assert := assert.New(t)
 	strong := func() *courier.Courier {
-		cur, err := courier.NewCourier("strong", 5, kernel.NewRandomLocation())
+		cur, err := courier.NewCourier("strong", courier.TransportTypeCar, 5, kernel.NewRandomLocation())
 		assert.NoError(err)
-		assert.NoError(cur.AddStoragePlace("BigBag", 20))
+		assert.NoError(cur.AddStoragePlace("BigBag", courier.StoragePlaceKindAmbient, 20, 0))
 		return cur
 	}()
 
-	dispatcher := services.NewOrderDispatcher()
+	dispatcher := services.NewOrderDispatcher(kernel.DefaultArea())
 
 	tests := []struct {
 		name     string
@@ -39,13 +40,29 @@ func Test_orderDispatcher_Dispatch(t *testing.T) {
 				return order
 			}(),
 			couriers: []*courier.Courier{strong, func() *courier.Courier {
-				cur, err := courier.NewCourier("weak", 1, kernel.NewRandomLocation())
+				cur, err := courier.NewCourier("weak", courier.TransportTypePedestrian, 1, kernel.NewRandomLocation())
 				assert.NoError(err)
 				return cur
 			}()},
 			want:    strong,
 			wantErr: nil,
 		},
+		{
+			name: "bad courier on break",
+			order: func() *order.Order {
+				order, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+				assert.NoError(err)
+				return order
+			}(),
+			couriers: []*courier.Courier{func() *courier.Courier {
+				cur, err := courier.NewCourier("resting", courier.TransportTypeCar, 5, kernel.NewRandomLocation())
+				assert.NoError(err)
+				assert.NoError(cur.StartBreak())
+				return cur
+			}()},
+			want:    nil,
+			wantErr: services.ErrNoRightCourier,
+		},
 		{
 			name:     "bad no order",
 			order:    nil,
@@ -68,7 +85,7 @@ func Test_orderDispatcher_Dispatch(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := dispatcher.Dispatch(tt.order, tt.couriers)
+			got, err := dispatcher.Dispatch(tt.order, tt.couriers, nil)
 			if err != nil {
 				assert.ErrorIs(err, tt.wantErr)
 			} else {
@@ -79,3 +96,202 @@ func Test_orderDispatcher_Dispatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_orderDispatcher_DispatchWithDeadline(t *testing.T) {
+	assert := assert.New(t)
+
+	start, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	target, err := kernel.NewLocation(1, 5)
+	assert.NoError(err)
+
+	// Курьеру нужно 4 шага по минуте
+	dispatch := func(promisedIn time.Duration) *order.Order {
+		ordering, err := order.NewOrder(uuid.New(), target, 1)
+		assert.NoError(err)
+		deadline := ordering.CreatedAt().Add(promisedIn)
+		assert.NoError(ordering.SetDeliveryTerms(order.PriorityExpress, &deadline))
+		ordering.ClearDomainEvents()
+
+		walker, err := courier.NewCourier("walker", courier.TransportTypePedestrian, 1, start)
+		assert.NoError(err)
+
+		now := func() time.Time { return ordering.CreatedAt() }
+		dispatcher := services.NewOrderDispatcherWithClock(kernel.DefaultArea(), now, time.Minute)
+		got, err := dispatcher.Dispatch(ordering, []*courier.Courier{walker}, nil)
+		assert.NoError(err)
+		assert.True(walker.Equal(got))
+		return ordering
+	}
+
+	t.Run("good in time", func(t *testing.T) {
+		ordering := dispatch(5 * time.Minute)
+		for _, event := range ordering.GetDomainEvents() {
+			assert.NotEqual(order.EventNameOrderSLABreachPredicted, event.GetName())
+		}
+	})
+
+	t.Run("good late is reported", func(t *testing.T) {
+		ordering := dispatch(3 * time.Minute)
+		assert.Equal(order.StatusAssigned, ordering.Status())
+
+		var breach *order.OrderSLABreachPredicted
+		for _, event := range ordering.GetDomainEvents() {
+			if e, ok := event.(order.OrderSLABreachPredicted); ok {
+				breach = &e
+			}
+		}
+		if assert.NotNil(breach) {
+			assert.Equal(time.Minute, breach.Delay())
+		}
+	})
+}
+
+func Test_orderDispatcher_DispatchCountsPickupLeg(t *testing.T) {
+	assert := assert.New(t)
+
+	pickup, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+	dropoff, err := kernel.NewLocation(9, 9)
+	assert.NoError(err)
+
+	ordering, err := order.NewOrder(uuid.New(), dropoff, 1)
+	assert.NoError(err)
+	assert.NoError(ordering.SetPickup(pickup))
+
+	// Курьер у адреса клиента должен сначала съездить за заказом: 32 шага против 16
+	atCustomer, err := courier.NewCourier("at customer", courier.TransportTypeCar, 4, dropoff)
+	assert.NoError(err)
+	atWarehouse, err := courier.NewCourier("at warehouse", courier.TransportTypeCar, 4, pickup)
+	assert.NoError(err)
+
+	dt, err := atCustomer.CalculateTimeToDeliver(ordering, kernel.DefaultArea())
+	assert.NoError(err)
+	assert.Equal(8.0, dt)
+
+	got, err := services.NewOrderDispatcher(kernel.DefaultArea()).Dispatch(ordering, []*courier.Courier{atCustomer, atWarehouse}, nil)
+	assert.NoError(err)
+	assert.True(atWarehouse.Equal(got))
+}
+
+func Test_orderDispatcher_DispatchCountsPlannedRoute(t *testing.T) {
+	assert := assert.New(t)
+
+	location := func(x, y int) kernel.Location {
+		l, err := kernel.NewLocation(x, y)
+		assert.NoError(err)
+		return l
+	}
+
+	// Занятый курьер ближе, но сначала отвезет свой заказ: 4 + 9 шагов против 9 у свободного
+	busy, err := courier.NewCourier("busy", courier.TransportTypeCar, 3, location(5, 5))
+	assert.NoError(err)
+	carried, err := order.NewOrder(uuid.New(), location(5, 1), 1)
+	assert.NoError(err)
+	assert.NoError(busy.TakeOrder(carried))
+	assert.NoError(carried.Assign(busy.ID()))
+	stop, err := courier.NewRouteStop(carried.ID(), carried.Location())
+	assert.NoError(err)
+	assert.NoError(busy.SetRoute([]courier.RouteStop{stop}))
+
+	idle, err := courier.NewCourier("idle", courier.TransportTypeCar, 3, location(5, 1))
+	assert.NoError(err)
+
+	newExpress := func() *order.Order {
+		ordering, err := order.NewOrder(uuid.New(), location(5, 10), 1)
+		assert.NoError(err)
+		deadline := ordering.CreatedAt().Add(4 * time.Minute)
+		assert.NoError(ordering.SetDeliveryTerms(order.PriorityExpress, &deadline))
+		ordering.ClearDomainEvents()
+		return ordering
+	}
+	isBreachReported := func(ordering *order.Order) bool {
+		for _, event := range ordering.GetDomainEvents() {
+			if event.GetName() == order.EventNameOrderSLABreachPredicted {
+				return true
+			}
+		}
+		return false
+	}
+
+	ordering := newExpress()
+	now := func() time.Time { return ordering.CreatedAt() }
+	dispatcher := services.NewOrderDispatcherWithClock(kernel.DefaultArea(), now, time.Minute)
+	got, err := dispatcher.Dispatch(ordering, []*courier.Courier{busy, idle}, nil)
+	assert.NoError(err)
+	assert.True(idle.Equal(got))
+	assert.False(isBreachReported(ordering))
+
+	// Без свободного курьера срок не обещается: 5 шагов при сроке в 4 минуты
+	late := newExpress()
+	got, err = dispatcher.Dispatch(late, []*courier.Courier{busy}, nil)
+	assert.NoError(err)
+	assert.True(busy.Equal(got))
+	assert.True(isBreachReported(late))
+}
+
+func Test_orderDispatcher_DispatchMatchesHandling(t *testing.T) {
+	assert := assert.New(t)
+
+	location, err := kernel.NewLocation(5, 5)
+	assert.NoError(err)
+	near, err := kernel.NewLocation(5, 6)
+	assert.NoError(err)
+	far, err := kernel.NewLocation(1, 1)
+	assert.NoError(err)
+
+	// Ближайший курьер без холодильника не рассматривается
+	closest, err := courier.NewCourier("closest", courier.TransportTypeCar, 5, near)
+	assert.NoError(err)
+	fridge, err := courier.NewCourier("fridge", courier.TransportTypePedestrian, 1, far)
+	assert.NoError(err)
+	assert.NoError(fridge.AddStoragePlace("Холодильник", courier.StoragePlaceKindRefrigerated, 10, 0))
+
+	frozen, err := order.NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	assert.NoError(frozen.SetHandling(order.HandlingRefrigerated))
+
+	got, err := services.NewOrderDispatcher(kernel.DefaultArea()).Dispatch(frozen, []*courier.Courier{closest, fridge}, nil)
+	assert.NoError(err)
+	assert.Equal(fridge, got)
+
+	another, err := order.NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	assert.NoError(another.SetHandling(order.HandlingRefrigerated))
+	_, err = services.NewOrderDispatcher(kernel.DefaultArea()).Dispatch(another, []*courier.Courier{closest}, nil)
+	assert.ErrorIs(err, services.ErrNoRightCourier)
+}
+
+func Test_orderDispatcher_DispatchBreaksTieByStats(t *testing.T) {
+	assert := assert.New(t)
+
+	location, err := kernel.NewLocation(5, 5)
+	assert.NoError(err)
+	left, err := kernel.NewLocation(3, 5)
+	assert.NoError(err)
+	right, err := kernel.NewLocation(7, 5)
+	assert.NoError(err)
+
+	// Курьеры одинаково далеко, выбираем курьера с лучшей оценкой
+	first, err := courier.NewCourier("first", courier.TransportTypePedestrian, 1, left)
+	assert.NoError(err)
+	second, err := courier.NewCourier("second", courier.TransportTypePedestrian, 1, right)
+	assert.NoError(err)
+	stats := map[uuid.UUID]courier.Stats{
+		first.ID():  courier.RestoreStats(first.ID(), 10, 100, 10*time.Hour, 10, 30, 10),
+		second.ID(): courier.RestoreStats(second.ID(), 10, 100, 10*time.Hour, 10, 48, 10),
+	}
+
+	ordering, err := order.NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	got, err := services.NewOrderDispatcher(kernel.DefaultArea()).Dispatch(ordering, []*courier.Courier{first, second}, stats)
+	assert.NoError(err)
+	assert.Equal(second, got)
+
+	// Без статистики остается первый из равных
+	another, err := order.NewOrder(uuid.New(), location, 1)
+	assert.NoError(err)
+	got, err = services.NewOrderDispatcher(kernel.DefaultArea()).Dispatch(another, []*courier.Courier{first, second}, nil)
+	assert.NoError(err)
+	assert.Equal(first, got)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+)
+
+var ErrCantReassignOrder = errors.New("cannot reassign the order")
+
+// OrderReassigner takes assigned and picked up orders from the courier.
+// A picked up order is left at the courier's location and collected there by the next courier.
+type OrderReassigner interface {
+	// Unassign takes the order from the courier and returns it to the dispatch queue.
+	Unassign(ordering *order.Order, from *courier.Courier) error
+	// Reassign hands the order over from one courier to another.
+	Reassign(ordering *order.Order, from *courier.Courier, to *courier.Courier) error
+}
+
+var _ OrderReassigner = (*orderReassigner)(nil)
+
+type orderReassigner struct{}
+
+func NewOrderReassigner() OrderReassigner { return new(orderReassigner) }
+
+func (s *orderReassigner) Unassign(ordering *order.Order, from *courier.Courier) error {
+	if err := s.validate(ordering, from); err != nil {
+		return err
+	}
+
+	if err := from.ReleaseOrder(ordering); err != nil {
+		return err
+	}
+
+	if ordering.Status().Equals(order.StatusPickedUp) {
+		if err := ordering.LeaveAt(from.Location()); err != nil {
+			return err
+		}
+	}
+	return ordering.Unassign()
+}
+
+func (s *orderReassigner) Reassign(ordering *order.Order, from *courier.Courier, to *courier.Courier) error {
+	if err := s.validate(ordering, from); err != nil {
+		return err
+	}
+	if to == nil {
+		return errs.NewValueIsRequiredError("to")
+	}
+	if to.ID() == from.ID() {
+		return errors.Join(ErrCantReassignOrder, errs.NewValueIsInvalidError("to"))
+	}
+
+	if !to.IsOnline() {
+		return errors.Join(ErrCantReassignOrder, courier.ErrCourierIsNotAvailable)
+	}
+
+	canTake, err := to.CanTakeOrder(ordering)
+	if err != nil {
+		return err
+	}
+	if !canTake {
+		return errors.Join(ErrCantReassignOrder, courier.ErrNoSuitableStoragePlace)
+	}
+
+	if err := s.Unassign(ordering, from); err != nil {
+		return err
+	}
+
+	if err := to.TakeOrder(ordering); err != nil {
+		return errors.Join(ErrCantReassignOrder, err)
+	}
+
+	return ordering.Assign(to.ID())
+}
+
+func (s *orderReassigner) validate(ordering *order.Order, from *courier.Courier) error {
+	if ordering == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
+	if from == nil {
+		return errs.NewValueIsRequiredError("from")
+	}
+	if !ordering.Status().Equals(order.StatusAssigned) && !ordering.Status().Equals(order.StatusPickedUp) {
+		return errs.NewExpectationFailedError("status", ordering.Status(), order.StatusAssigned, order.StatusPickedUp)
+	}
+	if ordering.CourierID() == nil || *ordering.CourierID() != from.ID() {
+		return errs.NewExpectationFailedError("courier", from.ID(), ordering.CourierID())
+	}
+	return nil
+}
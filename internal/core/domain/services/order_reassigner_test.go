@@ -0,0 +1,126 @@
+package services_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_orderReassigner(t *testing.T) {
+	assert := assert.New(t)
+	reassigner := services.NewOrderReassigner()
+
+	newCourier := func() *courier.Courier {
+		cur, err := courier.NewCourier("test", courier.TransportTypePedestrian, 1, kernel.NewRandomLocation())
+		assert.NoError(err)
+		return cur
+	}
+	newAssignedOrder := func(c *courier.Courier, volume int) *order.Order {
+		o, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), volume)
+		assert.NoError(err)
+		assert.NoError(c.TakeOrder(o))
+		assert.NoError(o.Assign(c.ID()))
+		return o
+	}
+	isOccupied := func(c *courier.Courier) bool {
+		for _, sp := range c.StoragePlaces() {
+			if sp.IsOccupied() {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("good unassign", func(t *testing.T) {
+		from := newCourier()
+		o := newAssignedOrder(from, 1)
+
+		assert.NoError(reassigner.Unassign(o, from))
+		assert.Equal(order.StatusCreated, o.Status())
+		assert.Nil(o.CourierID())
+		assert.False(isOccupied(from))
+	})
+
+	t.Run("good reassign", func(t *testing.T) {
+		from, to := newCourier(), newCourier()
+		o := newAssignedOrder(from, 1)
+
+		assert.NoError(reassigner.Reassign(o, from, to))
+		assert.Equal(order.StatusAssigned, o.Status())
+		assert.Equal(to.ID(), *o.CourierID())
+		assert.False(isOccupied(from))
+		assert.True(isOccupied(to))
+	})
+
+	t.Run("good reassign picked up order", func(t *testing.T) {
+		from, to := newCourier(), newCourier()
+		o, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+		assert.NoError(err)
+		assert.NoError(o.SetPickup(kernel.NewRandomLocation()))
+		assert.NoError(from.TakeOrder(o))
+		assert.NoError(o.Assign(from.ID()))
+		assert.NoError(o.PickUp())
+
+		// Новый курьер забирает заказ у прежнего
+		assert.NoError(reassigner.Reassign(o, from, to))
+		assert.Equal(order.StatusAssigned, o.Status())
+		assert.Equal(to.ID(), *o.CourierID())
+		assert.True(from.Location().Equals(o.Pickup()))
+		assert.False(isOccupied(from))
+		assert.True(isOccupied(to))
+	})
+
+	t.Run("good unassign picked up order", func(t *testing.T) {
+		from := newCourier()
+		o, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+		assert.NoError(err)
+		assert.NoError(o.SetPickup(kernel.NewRandomLocation()))
+		assert.NoError(from.TakeOrder(o))
+		assert.NoError(o.Assign(from.ID()))
+		assert.NoError(o.PickUp())
+
+		assert.NoError(reassigner.Unassign(o, from))
+		assert.Equal(order.StatusCreated, o.Status())
+		assert.True(from.Location().Equals(o.Pickup()))
+		assert.False(isOccupied(from))
+	})
+
+	t.Run("bad reassign to busy courier", func(t *testing.T) {
+		from, to := newCourier(), newCourier()
+		o := newAssignedOrder(from, 1)
+		newAssignedOrder(to, 10)
+
+		assert.ErrorIs(reassigner.Reassign(o, from, to), services.ErrCantReassignOrder)
+		// Заказ остается у прежнего курьера
+		assert.Equal(from.ID(), *o.CourierID())
+		assert.True(isOccupied(from))
+	})
+
+	t.Run("bad reassign to same courier", func(t *testing.T) {
+		from := newCourier()
+		o := newAssignedOrder(from, 1)
+
+		assert.ErrorIs(reassigner.Reassign(o, from, from), services.ErrCantReassignOrder)
+	})
+
+	t.Run("bad wrong courier", func(t *testing.T) {
+		from := newCourier()
+		o := newAssignedOrder(from, 1)
+
+		assert.ErrorIs(reassigner.Unassign(o, newCourier()), errs.ErrExpectationFailed)
+	})
+
+	t.Run("bad not assigned", func(t *testing.T) {
+		o, err := order.NewOrder(uuid.New(), kernel.NewRandomLocation(), 1)
+		assert.NoError(err)
+
+		assert.ErrorIs(reassigner.Unassign(o, newCourier()), errs.ErrExpectationFailed)
+	})
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// RoutePlanner orders the stops so that the courier drives as little as possible.
+type RoutePlanner interface {
+	Plan(start kernel.Location, stops []courier.RouteStop) ([]courier.RouteStop, error)
+}
+
+var _ RoutePlanner = (*routePlanner)(nil)
+
+type routePlanner struct {
+	area kernel.Area
+}
+
+// NewRoutePlanner makes a planner which measures distances in the delivery area.
+func NewRoutePlanner(area kernel.Area) RoutePlanner { return &routePlanner{area: area} }
+
+// Plan builds the route with the nearest neighbour heuristic and improves it with 2-opt.
+// The route is open: the courier does not return to the start. The pickup of an order
+// is always visited before its drop-off.
+func (p *routePlanner) Plan(start kernel.Location, stops []courier.RouteStop) ([]courier.RouteStop, error) {
+	if !start.IsValid() {
+		return nil, errs.NewValueIsRequiredError("start")
+	}
+
+	for _, stop := range stops {
+		if !stop.IsValid() {
+			return nil, errs.NewValueIsInvalidError("stop")
+		}
+	}
+
+	route, err := p.nearestNeighbour(start, stops)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.twoOpt(start, route)
+}
+
+func (p *routePlanner) nearestNeighbour(start kernel.Location, stops []courier.RouteStop) ([]courier.RouteStop, error) {
+	pending := make([]courier.RouteStop, len(stops))
+	copy(pending, stops)
+
+	route := make([]courier.RouteStop, 0, len(stops))
+	current := start
+	for len(pending) > 0 {
+		index, best := 0, -1
+		for i, stop := range pending {
+			if !stop.IsPickup() && hasPickup(pending, stop.OrderID()) {
+				continue
+			}
+			distance, err := p.area.Distance(current, stop.Location())
+			if err != nil {
+				return nil, err
+			}
+			if best < 0 || distance < best {
+				index, best = i, distance
+			}
+		}
+
+		route = append(route, pending[index])
+		current = pending[index].Location()
+		pending = append(pending[:index], pending[index+1:]...)
+	}
+
+	return route, nil
+}
+
+func (p *routePlanner) twoOpt(start kernel.Location, route []courier.RouteStop) ([]courier.RouteStop, error) {
+	best, err := p.length(start, route)
+	if err != nil {
+		return nil, err
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < len(route)-1; i++ {
+			for j := i + 1; j < len(route); j++ {
+				candidate := reverse(route, i, j)
+				if !isFeasible(candidate) {
+					continue
+				}
+				length, err := p.length(start, candidate)
+				if err != nil {
+					return nil, err
+				}
+				if length < best {
+					route, best, improved = candidate, length, true
+				}
+			}
+		}
+	}
+
+	return route, nil
+}
+
+func (p *routePlanner) length(start kernel.Location, route []courier.RouteStop) (int, error) {
+	total, current := 0, start
+	for _, stop := range route {
+		distance, err := p.area.Distance(current, stop.Location())
+		if err != nil {
+			return 0, err
+		}
+		total += distance
+		current = stop.Location()
+	}
+	return total, nil
+}
+
+// reverse returns a copy of the route with the stops from i to j in reverse order.
+func reverse(route []courier.RouteStop, i, j int) []courier.RouteStop {
+	res := make([]courier.RouteStop, len(route))
+	copy(res, route)
+	for ; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+	return res
+}
+
+func hasPickup(stops []courier.RouteStop, orderID uuid.UUID) bool {
+	for _, stop := range stops {
+		if stop.OrderID() == orderID && stop.IsPickup() {
+			return true
+		}
+	}
+	return false
+}
+
+// isFeasible reports whether no order is handed over before it is collected.
+func isFeasible(route []courier.RouteStop) bool {
+	delivered := make(map[uuid.UUID]struct{}, len(route))
+	for _, stop := range route {
+		if !stop.IsPickup() {
+			delivered[stop.OrderID()] = struct{}{}
+			continue
+		}
+		if _, ok := delivered[stop.OrderID()]; ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+package services_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_routePlanner_Plan(t *testing.T) {
+	assert := assert.New(t)
+	location := func(x, y int) kernel.Location {
+		loc, err := kernel.NewLocation(x, y)
+		assert.NoError(err)
+		return loc
+	}
+	stop := func(x, y int) courier.RouteStop {
+		s, err := courier.NewRouteStop(uuid.New(), location(x, y))
+		assert.NoError(err)
+		return s
+	}
+	length := func(start kernel.Location, route []courier.RouteStop) int {
+		total, current := 0, start
+		for _, s := range route {
+			d, err := current.DistanceTo(s.Location())
+			assert.NoError(err)
+			total, current = total+d, s.Location()
+		}
+		return total
+	}
+
+	planner := services.NewRoutePlanner(kernel.DefaultArea())
+
+	t.Run("empty", func(t *testing.T) {
+		route, err := planner.Plan(location(1, 1), nil)
+		assert.NoError(err)
+		assert.Empty(route)
+	})
+
+	t.Run("bad start", func(t *testing.T) {
+		_, err := planner.Plan(kernel.Location{}, []courier.RouteStop{stop(1, 1)})
+		assert.ErrorIs(err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("bad stop", func(t *testing.T) {
+		_, err := planner.Plan(location(1, 1), []courier.RouteStop{{}})
+		assert.ErrorIs(err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("nearest first", func(t *testing.T) {
+		far, near, middle := stop(9, 9), stop(2, 2), stop(5, 5)
+		route, err := planner.Plan(location(1, 1), []courier.RouteStop{far, near, middle})
+		assert.NoError(err)
+		assert.Equal([]courier.RouteStop{near, middle, far}, route)
+	})
+
+	t.Run("2-opt improves nearest neighbour", func(t *testing.T) {
+		// Жадный алгоритм дает маршрут длиной 19, оптимальный - 15
+		start := location(4, 5)
+		a, b, c, d := stop(2, 10), stop(3, 6), stop(5, 2), stop(3, 3)
+		route, err := planner.Plan(start, []courier.RouteStop{a, b, c, d})
+		assert.NoError(err)
+		assert.Equal([]courier.RouteStop{c, d, b, a}, route)
+		assert.Equal(15, length(start, route))
+	})
+
+	t.Run("pickup before dropoff", func(t *testing.T) {
+		// Адрес клиента рядом, но сначала нужно забрать заказ в дальней точке выдачи
+		start := location(1, 1)
+		orderID := uuid.New()
+		pickup, err := courier.NewPickupStop(orderID, location(9, 9))
+		assert.NoError(err)
+		dropoff, err := courier.NewRouteStop(orderID, location(2, 2))
+		assert.NoError(err)
+		other := stop(8, 8)
+
+		route, err := planner.Plan(start, []courier.RouteStop{dropoff, other, pickup})
+		assert.NoError(err)
+		assert.Len(route, 3)
+		assert.Less(indexOf(route, pickup), indexOf(route, dropoff))
+		assert.Equal(30, length(start, route))
+	})
+}
+
+func indexOf(route []courier.RouteStop, stop courier.RouteStop) int {
+	for i, s := range route {
+		if s == stop {
+			return i
+		}
+	}
+	return -1
+}
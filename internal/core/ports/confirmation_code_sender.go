@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ConfirmationCodeSender delivers the delivery confirmation code to the customer.
+type ConfirmationCodeSender interface {
+	Send(ctx context.Context, orderID uuid.UUID, code string) error
+}
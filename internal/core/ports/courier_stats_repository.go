@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+
+	"github.com/google/uuid"
+)
+
+// ErrOrderAlreadyRated is returned when the customer rates the same delivery twice.
+var ErrOrderAlreadyRated = errors.New("order already rated")
+
+// CourierStatsRepository accumulates courier stats outside of the unit of work,
+// the counters are fed from the outbox with committed events.
+// Events are delivered at least once, so every increment is idempotent on the event ID.
+type CourierStatsRepository interface {
+	// AddDelivery counts the delivered order, a zero deliveryTime is unknown and is left out of the average.
+	AddDelivery(ctx context.Context, eventID uuid.UUID, courierID uuid.UUID, deliveryTime time.Duration) error
+	// AddDistance adds the distance travelled in grid steps of the delivery area.
+	AddDistance(ctx context.Context, eventID uuid.UUID, courierID uuid.UUID, distance int) error
+	// AddRating stores the score of the delivered order or returns ErrOrderAlreadyRated.
+	AddRating(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID, score int) error
+	Get(ctx context.Context, courierID uuid.UUID) (courier.Stats, error)
+	GetAll(ctx context.Context, courierIDs []uuid.UUID) (map[uuid.UUID]courier.Stats, error)
+}
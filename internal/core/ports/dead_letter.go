@@ -0,0 +1,41 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeadLetter is an inbound message which could not be handled.
+type DeadLetter struct {
+	ID            uuid.UUID
+	MessageID     string
+	Source        string
+	Payload       []byte
+	Err           error
+	Attempts      int
+	CreatedAtUtc  time.Time
+	ReplayedAtUtc *time.Time
+}
+
+func (d DeadLetter) IsReplayed() bool {
+	return d.ReplayedAtUtc != nil
+}
+
+type DeadLetterRepository interface {
+	Add(ctx context.Context, deadLetter DeadLetter) error
+	Update(ctx context.Context, deadLetter DeadLetter) error
+	Get(ctx context.Context, ID uuid.UUID) (DeadLetter, error)
+}
+
+// DeadLetterPublisher forwards dead letters to an external queue.
+type DeadLetterPublisher interface {
+	Publish(ctx context.Context, deadLetter DeadLetter) error
+}
+
+// MessageReplayer handles a dead letter once more by the inbound adapter it came from.
+type MessageReplayer interface {
+	Source() string
+	Replay(ctx context.Context, deadLetter DeadLetter) error
+}
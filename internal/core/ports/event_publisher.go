@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/pkg/ddd"
+)
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event ddd.DomainEvent) error
+}
@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrMessageAlreadyProcessed is returned when an inbound message has already
+// been registered in the inbox, so the command must not be executed again.
+var ErrMessageAlreadyProcessed = errors.New("message already processed")
+
+// InboxMessage identifies an inbound integration message.
+type InboxMessage struct {
+	ID     string
+	Source string
+}
+
+type InboxRepository interface {
+	// Register stores the message in the inbox within the current transaction
+	// or returns ErrMessageAlreadyProcessed.
+	Register(ctx context.Context, message InboxMessage) error
+}
+
+type inboxMessageKey struct{}
+
+// WithInboxMessage attaches an inbound message to the context, so command
+// handlers can deduplicate it in their unit of work.
+func WithInboxMessage(ctx context.Context, message InboxMessage) context.Context {
+	return context.WithValue(ctx, inboxMessageKey{}, message)
+}
+
+func InboxMessageFromContext(ctx context.Context) (InboxMessage, bool) {
+	message, ok := ctx.Value(inboxMessageKey{}).(InboxMessage)
+	return message, ok
+}
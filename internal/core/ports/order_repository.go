@@ -2,6 +2,8 @@ package ports
 
 import (
 	"context"
+	"time"
+
 	"delivery/internal/core/domain/model/order"
 
 	"github.com/google/uuid"
@@ -11,6 +13,8 @@ type OrderRepository interface {
 	Add(ctx context.Context, aggregate *order.Order) error
 	Update(ctx context.Context, aggregate *order.Order) error
 	Get(ctx context.Context, ID uuid.UUID) (*order.Order, error)
-	GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error)
-	GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error)
+	GetAllInCreatedStatus(ctx context.Context) ([]*order.Order, error)
+	GetAllWithCouriers(ctx context.Context) ([]*order.Order, error)
+	GetAllAwaitingConfirmation(ctx context.Context, arrivedBefore time.Time) ([]*order.Order, error)
+	GetAllFailed(ctx context.Context) ([]*order.Order, error)
 }
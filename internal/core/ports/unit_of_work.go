@@ -16,4 +16,5 @@ type UnitOfWork interface {
 	// Domain specific
 	OrderRepository() OrderRepository
 	CourierRepository() CourierRepository
+	InboxRepository() InboxRepository
 }
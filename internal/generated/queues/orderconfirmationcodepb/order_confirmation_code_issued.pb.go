@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v4.25.5
+// source: api/proto/order_confirmation_code_issued.proto
+
+package orderconfirmationcodepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Confirmation code issued integration event, the code is sent to the customer
+type OrderConfirmationCodeIssuedIntegrationEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	// Code the customer tells the courier on delivery
+	Code          string `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderConfirmationCodeIssuedIntegrationEvent) Reset() {
+	*x = OrderConfirmationCodeIssuedIntegrationEvent{}
+	mi := &file_api_proto_order_confirmation_code_issued_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderConfirmationCodeIssuedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderConfirmationCodeIssuedIntegrationEvent) ProtoMessage() {}
+
+func (x *OrderConfirmationCodeIssuedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_confirmation_code_issued_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderConfirmationCodeIssuedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*OrderConfirmationCodeIssuedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_confirmation_code_issued_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderConfirmationCodeIssuedIntegrationEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderConfirmationCodeIssuedIntegrationEvent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+var File_api_proto_order_confirmation_code_issued_proto protoreflect.FileDescriptor
+
+const file_api_proto_order_confirmation_code_issued_proto_rawDesc = "" +
+	"\n" +
+	".api/proto/order_confirmation_code_issued.proto\x12\bdelivery\"[\n" +
+	"+OrderConfirmationCodeIssuedIntegrationEvent\x12\x18\n" +
+	"\aOrderId\x18\x01 \x01(\tR\aOrderId\x12\x12\n" +
+	"\x04Code\x18\x02 \x01(\tR\x04CodeB2Z\x1equeues/orderconfirmationcodepb\xaa\x02\x0fDeliveryApp.Apib\x06proto3"
+
+var (
+	file_api_proto_order_confirmation_code_issued_proto_rawDescOnce sync.Once
+	file_api_proto_order_confirmation_code_issued_proto_rawDescData []byte
+)
+
+func file_api_proto_order_confirmation_code_issued_proto_rawDescGZIP() []byte {
+	file_api_proto_order_confirmation_code_issued_proto_rawDescOnce.Do(func() {
+		file_api_proto_order_confirmation_code_issued_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_order_confirmation_code_issued_proto_rawDesc), len(file_api_proto_order_confirmation_code_issued_proto_rawDesc)))
+	})
+	return file_api_proto_order_confirmation_code_issued_proto_rawDescData
+}
+
+var file_api_proto_order_confirmation_code_issued_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_proto_order_confirmation_code_issued_proto_goTypes = []any{
+	(*OrderConfirmationCodeIssuedIntegrationEvent)(nil), // 0: delivery.OrderConfirmationCodeIssuedIntegrationEvent
+}
+var file_api_proto_order_confirmation_code_issued_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_order_confirmation_code_issued_proto_init() }
+func file_api_proto_order_confirmation_code_issued_proto_init() {
+	if File_api_proto_order_confirmation_code_issued_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_order_confirmation_code_issued_proto_rawDesc), len(file_api_proto_order_confirmation_code_issued_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_order_confirmation_code_issued_proto_goTypes,
+		DependencyIndexes: file_api_proto_order_confirmation_code_issued_proto_depIdxs,
+		MessageInfos:      file_api_proto_order_confirmation_code_issued_proto_msgTypes,
+	}.Build()
+	File_api_proto_order_confirmation_code_issued_proto = out.File
+	file_api_proto_order_confirmation_code_issued_proto_goTypes = nil
+	file_api_proto_order_confirmation_code_issued_proto_depIdxs = nil
+}
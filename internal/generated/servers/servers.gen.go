@@ -14,21 +14,171 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CourierAvailability.
+const (
+	Offline CourierAvailability = "Offline"
+	OnBreak CourierAvailability = "OnBreak"
+	Online  CourierAvailability = "Online"
+)
+
+// Defines values for FailureReason.
+const (
+	AddressNotFound FailureReason = "AddressNotFound"
+	CustomerNotHome FailureReason = "CustomerNotHome"
+	NotConfirmed    FailureReason = "NotConfirmed"
+	Other           FailureReason = "Other"
+	Refused         FailureReason = "Refused"
+)
+
+// Defines values for OrderHandling.
+const (
+	OrderHandlingAmbient      OrderHandling = "Ambient"
+	OrderHandlingInsulated    OrderHandling = "Insulated"
+	OrderHandlingRefrigerated OrderHandling = "Refrigerated"
+)
+
+// Defines values for OrderPriority.
+const (
+	Express  OrderPriority = "Express"
+	Standard OrderPriority = "Standard"
+)
+
+// Defines values for OrderStatus.
+const (
+	Assigned             OrderStatus = "Assigned"
+	AwaitingConfirmation OrderStatus = "AwaitingConfirmation"
+	Cancelled            OrderStatus = "Cancelled"
+	Completed            OrderStatus = "Completed"
+	Created              OrderStatus = "Created"
+	Failed               OrderStatus = "Failed"
+	PickedUp             OrderStatus = "PickedUp"
+	ReturnedToSender     OrderStatus = "ReturnedToSender"
+)
+
+// Defines values for RouteStopKind.
+const (
+	Dropoff RouteStopKind = "Dropoff"
+	Pickup  RouteStopKind = "Pickup"
+)
+
+// Defines values for StoragePlaceKind.
+const (
+	StoragePlaceKindAmbient      StoragePlaceKind = "Ambient"
+	StoragePlaceKindInsulated    StoragePlaceKind = "Insulated"
+	StoragePlaceKindRefrigerated StoragePlaceKind = "Refrigerated"
+)
+
+// Defines values for TransportType.
+const (
+	Bicycle    TransportType = "Bicycle"
+	Car        TransportType = "Car"
+	Pedestrian TransportType = "Pedestrian"
+	Scooter    TransportType = "Scooter"
+)
+
 // Courier defines model for Courier.
 type Courier struct {
+	// Availability Доступность
+	Availability CourierAvailability `json:"availability"`
+
 	// Id Идентификатор
-	Id       openapi_types.UUID `json:"id"`
-	Location Location           `json:"location"`
+	Id openapi_types.UUID `json:"id"`
+
+	// Location Координата в зоне доставки. Границы зоны задаются при развертывании (AREA_MIN_X, AREA_MIN_Y, AREA_MAX_X, AREA_MAX_Y), значения ниже - зона по умолчанию; /openapi.json отдает фактические границы. В географической зоне x и y - клетка сетки, а lat и lon - точные координаты адреса.
+	Location Location `json:"location"`
 
 	// Name Имя
 	Name string `json:"name"`
+
+	// Route Запланированный маршрут
+	Route *[]RouteStop `json:"route,omitempty"`
+
+	// StoragePlaces Места хранения
+	StoragePlaces *[]StoragePlace `json:"storagePlaces,omitempty"`
+
+	// TransportType Вид транспорта, определяет допустимую скорость и набор мест хранения
+	TransportType TransportType `json:"transportType"`
+}
+
+// CourierAvailability Доступность
+type CourierAvailability string
+
+// CourierStats defines model for CourierStats.
+type CourierStats struct {
+	// AverageDeliveryTimeSeconds Среднее время от создания до вручения заказа в секундах
+	AverageDeliveryTimeSeconds int `json:"averageDeliveryTimeSeconds"`
+
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// DeliveredOrders Доставлено заказов
+	DeliveredOrders int `json:"deliveredOrders"`
+
+	// Distance Пройденное расстояние в шагах
+	Distance int `json:"distance"`
+
+	// Rating Средняя оценка клиентов, отсутствует пока курьера не оценили
+	Rating *float64 `json:"rating,omitempty"`
+
+	// RatingCount Количество оценок
+	RatingCount int `json:"ratingCount"`
+}
+
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	// Attempts Количество попыток обработки
+	Attempts int `json:"attempts"`
+
+	// CreatedAt Время перемещения в dead letters
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Текст ошибки
+	Error string `json:"error"`
+
+	// ErrorKind Тип ошибки
+	ErrorKind string `json:"errorKind"`
+
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// MessageId Идентификатор входящего сообщения
+	MessageId string `json:"messageId"`
+
+	// Payload Содержимое сообщения
+	Payload *string `json:"payload,omitempty"`
+
+	// ReplayedAt Время успешной повторной обработки
+	ReplayedAt *time.Time `json:"replayedAt,omitempty"`
+
+	// Source Источник сообщения (топик)
+	Source string `json:"source"`
+}
+
+// DeliveryConfirmation defines model for DeliveryConfirmation.
+type DeliveryConfirmation struct {
+	// Code Код подтверждения, который клиент сообщает курьеру
+	Code string `json:"code"`
+}
+
+// DeliveryFailure defines model for DeliveryFailure.
+type DeliveryFailure struct {
+	// Reason Причина неудачной доставки. NotConfirmed ставится автоматически, когда клиент не назвал верный код за отведенные попытки или время.
+	Reason FailureReason `json:"reason"`
+}
+
+// DeliveryRating defines model for DeliveryRating.
+type DeliveryRating struct {
+	// Score Оценка клиента от 1 до 5
+	Score int `json:"score"`
 }
 
 // Error defines model for Error.
@@ -40,8 +190,17 @@ type Error struct {
 	Message string `json:"message"`
 }
 
-// Location defines model for Location.
+// FailureReason Причина неудачной доставки. NotConfirmed ставится автоматически, когда клиент не назвал верный код за отведенные попытки или время.
+type FailureReason string
+
+// Location Координата в зоне доставки. Границы зоны задаются при развертывании (AREA_MIN_X, AREA_MIN_Y, AREA_MAX_X, AREA_MAX_Y), значения ниже - зона по умолчанию; /openapi.json отдает фактические границы. В географической зоне x и y - клетка сетки, а lat и lon - точные координаты адреса.
 type Location struct {
+	// Lat Широта, заполняется в географической зоне доставки (COORDINATE_SYSTEM=geo)
+	Lat *float64 `json:"lat,omitempty"`
+
+	// Lon Долгота, заполняется в географической зоне доставки (COORDINATE_SYSTEM=geo)
+	Lon *float64 `json:"lon,omitempty"`
+
 	// X X
 	X int `json:"x"`
 
@@ -56,32 +215,202 @@ type NewCourier struct {
 
 	// Speed Скорость
 	Speed int `json:"speed"`
+
+	// TransportType Вид транспорта, определяет допустимую скорость и набор мест хранения
+	TransportType *TransportType `json:"transportType,omitempty"`
+}
+
+// NewOrder defines model for NewOrder.
+type NewOrder struct {
+	// Handling Условия хранения заказа в пути. Охлаждаемое место подходит и для заказов, требующих термоизоляции, любое место - для обычных.
+	Handling *OrderHandling `json:"handling,omitempty"`
+
+	// PickupStreet Улица, где курьер забирает заказ, по умолчанию - склад
+	PickupStreet *string `json:"pickupStreet,omitempty"`
+
+	// Priority Срочные заказы назначаются раньше обычных
+	Priority *OrderPriority `json:"priority,omitempty"`
+
+	// PromisedBy Обещанный клиенту срок доставки
+	PromisedBy *time.Time `json:"promisedBy,omitempty"`
+
+	// Weight Вес заказа в граммах, 0 - неизвестен
+	Weight *int `json:"weight,omitempty"`
+}
+
+// NewStoragePlace defines model for NewStoragePlace.
+type NewStoragePlace struct {
+	// Kind Вид места хранения, определяет какие заказы в нем можно везти
+	Kind StoragePlaceKind `json:"kind"`
+
+	// MaxWeight Допустимая нагрузка в граммах, 0 - без ограничения
+	MaxWeight *int `json:"maxWeight,omitempty"`
+
+	// Name Название
+	Name string `json:"name"`
+
+	// TotalVolume Объем
+	TotalVolume int `json:"totalVolume"`
 }
 
 // Order defines model for Order.
 type Order struct {
+	// FailedAttempts Число неудачных попыток вручения
+	FailedAttempts *int `json:"failedAttempts,omitempty"`
+
+	// FailureReason Причина неудачной доставки. NotConfirmed ставится автоматически, когда клиент не назвал верный код за отведенные попытки или время.
+	FailureReason *FailureReason `json:"failureReason,omitempty"`
+
 	// Id Идентификатор
-	Id       openapi_types.UUID `json:"id"`
-	Location Location           `json:"location"`
+	Id openapi_types.UUID `json:"id"`
+
+	// Location Координата в зоне доставки. Границы зоны задаются при развертывании (AREA_MIN_X, AREA_MIN_Y, AREA_MAX_X, AREA_MAX_Y), значения ниже - зона по умолчанию; /openapi.json отдает фактические границы. В географической зоне x и y - клетка сетки, а lat и lon - точные координаты адреса.
+	Location Location `json:"location"`
+
+	// Pickup Координата в зоне доставки. Границы зоны задаются при развертывании (AREA_MIN_X, AREA_MIN_Y, AREA_MAX_X, AREA_MAX_Y), значения ниже - зона по умолчанию; /openapi.json отдает фактические границы. В географической зоне x и y - клетка сетки, а lat и lon - точные координаты адреса.
+	Pickup *Location `json:"pickup,omitempty"`
+
+	// Status Статус заказа
+	Status *OrderStatus `json:"status,omitempty"`
+}
+
+// OrderHandling Условия хранения заказа в пути. Охлаждаемое место подходит и для заказов, требующих термоизоляции, любое место - для обычных.
+type OrderHandling string
+
+// OrderPriority Срочные заказы назначаются раньше обычных
+type OrderPriority string
+
+// OrderStatus Статус заказа
+type OrderStatus string
+
+// ReassignOrder defines model for ReassignOrder.
+type ReassignOrder struct {
+	// CourierId Идентификатор нового курьера
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+}
+
+// RouteStop defines model for RouteStop.
+type RouteStop struct {
+	// Kind Забрать заказ в точке выдачи или передать клиенту
+	Kind *RouteStopKind `json:"kind,omitempty"`
+
+	// Location Координата в зоне доставки. Границы зоны задаются при развертывании (AREA_MIN_X, AREA_MIN_Y, AREA_MAX_X, AREA_MAX_Y), значения ниже - зона по умолчанию; /openapi.json отдает фактические границы. В географической зоне x и y - клетка сетки, а lat и lon - точные координаты адреса.
+	Location Location `json:"location"`
+
+	// OrderId Идентификатор заказа
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+// RouteStopKind Забрать заказ в точке выдачи или передать клиенту
+type RouteStopKind string
+
+// StoragePlace defines model for StoragePlace.
+type StoragePlace struct {
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// Kind Вид места хранения, определяет какие заказы в нем можно везти
+	Kind StoragePlaceKind `json:"kind"`
+
+	// MaxWeight Допустимая нагрузка в граммах, 0 - без ограничения
+	MaxWeight *int `json:"maxWeight,omitempty"`
+
+	// Name Название
+	Name string `json:"name"`
+
+	// OccupiedVolume Занятый объем
+	OccupiedVolume int `json:"occupiedVolume"`
+
+	// OrderIds Заказы в месте хранения
+	OrderIds *[]openapi_types.UUID `json:"orderIds,omitempty"`
+
+	// TotalVolume Объем
+	TotalVolume int `json:"totalVolume"`
 }
 
+// StoragePlaceKind Вид места хранения, определяет какие заказы в нем можно везти
+type StoragePlaceKind string
+
+// TransportType Вид транспорта, определяет допустимую скорость и набор мест хранения
+type TransportType string
+
 // CreateCourierJSONRequestBody defines body for CreateCourier for application/json ContentType.
 type CreateCourierJSONRequestBody = NewCourier
 
+// AddStoragePlaceJSONRequestBody defines body for AddStoragePlace for application/json ContentType.
+type AddStoragePlaceJSONRequestBody = NewStoragePlace
+
+// CreateOrderJSONRequestBody defines body for CreateOrder for application/json ContentType.
+type CreateOrderJSONRequestBody = NewOrder
+
+// ConfirmDeliveryJSONRequestBody defines body for ConfirmDelivery for application/json ContentType.
+type ConfirmDeliveryJSONRequestBody = DeliveryConfirmation
+
+// FailDeliveryJSONRequestBody defines body for FailDelivery for application/json ContentType.
+type FailDeliveryJSONRequestBody = DeliveryFailure
+
+// RateDeliveryJSONRequestBody defines body for RateDelivery for application/json ContentType.
+type RateDeliveryJSONRequestBody = DeliveryRating
+
+// ReassignOrderJSONRequestBody defines body for ReassignOrder for application/json ContentType.
+type ReassignOrderJSONRequestBody = ReassignOrder
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Получить необработанные входящие сообщения
+	// (GET /api/v1/admin/dead-letters)
+	GetDeadLetters(ctx echo.Context) error
+	// Получить необработанное сообщение
+	// (GET /api/v1/admin/dead-letters/{deadLetterId})
+	GetDeadLetter(ctx echo.Context, deadLetterId openapi_types.UUID) error
+	// Повторно обработать сообщение
+	// (POST /api/v1/admin/dead-letters/{deadLetterId}/replay)
+	ReplayDeadLetter(ctx echo.Context, deadLetterId openapi_types.UUID) error
 	// Получить всех курьеров
 	// (GET /api/v1/couriers)
 	GetCouriers(ctx echo.Context) error
 	// Добавить курьера
 	// (POST /api/v1/couriers)
 	CreateCourier(ctx echo.Context) error
+	// Уйти на перерыв
+	// (POST /api/v1/couriers/{courierId}/break)
+	StartCourierBreak(ctx echo.Context, courierId openapi_types.UUID) error
+	// Уйти с линии
+	// (POST /api/v1/couriers/{courierId}/offline)
+	SetCourierOffline(ctx echo.Context, courierId openapi_types.UUID) error
+	// Выйти на линию
+	// (POST /api/v1/couriers/{courierId}/online)
+	SetCourierOnline(ctx echo.Context, courierId openapi_types.UUID) error
+	// Получить статистику курьера
+	// (GET /api/v1/couriers/{courierId}/stats)
+	GetCourierStats(ctx echo.Context, courierId openapi_types.UUID) error
+	// Добавить место хранения
+	// (POST /api/v1/couriers/{courierId}/storage-places)
+	AddStoragePlace(ctx echo.Context, courierId openapi_types.UUID) error
+	// Снять место хранения
+	// (DELETE /api/v1/couriers/{courierId}/storage-places/{storagePlaceId})
+	RemoveStoragePlace(ctx echo.Context, courierId openapi_types.UUID, storagePlaceId openapi_types.UUID) error
 	// Создать заказ
 	// (POST /api/v1/orders)
 	CreateOrder(ctx echo.Context) error
 	// Получить все незавершенные заказы
 	// (GET /api/v1/orders/active)
 	GetOrders(ctx echo.Context) error
+	// Отменить заказ
+	// (POST /api/v1/orders/{orderId}/cancel)
+	CancelOrder(ctx echo.Context, orderId openapi_types.UUID) error
+	// Подтвердить доставку
+	// (POST /api/v1/orders/{orderId}/confirm)
+	ConfirmDelivery(ctx echo.Context, orderId openapi_types.UUID) error
+	// Отметить неудачную доставку
+	// (POST /api/v1/orders/{orderId}/fail)
+	FailDelivery(ctx echo.Context, orderId openapi_types.UUID) error
+	// Оценить доставку
+	// (POST /api/v1/orders/{orderId}/rating)
+	RateDelivery(ctx echo.Context, orderId openapi_types.UUID) error
+	// Переназначить заказ
+	// (POST /api/v1/orders/{orderId}/reassign)
+	ReassignOrder(ctx echo.Context, orderId openapi_types.UUID) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -89,6 +418,47 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetDeadLetters converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDeadLetters(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDeadLetters(ctx)
+	return err
+}
+
+// GetDeadLetter converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDeadLetter(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "deadLetterId" -------------
+	var deadLetterId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deadLetterId", ctx.Param("deadLetterId"), &deadLetterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deadLetterId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDeadLetter(ctx, deadLetterId)
+	return err
+}
+
+// ReplayDeadLetter converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplayDeadLetter(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "deadLetterId" -------------
+	var deadLetterId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deadLetterId", ctx.Param("deadLetterId"), &deadLetterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deadLetterId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReplayDeadLetter(ctx, deadLetterId)
+	return err
+}
+
 // GetCouriers converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCouriers(ctx echo.Context) error {
 	var err error
@@ -107,6 +477,110 @@ func (w *ServerInterfaceWrapper) CreateCourier(ctx echo.Context) error {
 	return err
 }
 
+// StartCourierBreak converts echo context to params.
+func (w *ServerInterfaceWrapper) StartCourierBreak(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartCourierBreak(ctx, courierId)
+	return err
+}
+
+// SetCourierOffline converts echo context to params.
+func (w *ServerInterfaceWrapper) SetCourierOffline(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetCourierOffline(ctx, courierId)
+	return err
+}
+
+// SetCourierOnline converts echo context to params.
+func (w *ServerInterfaceWrapper) SetCourierOnline(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetCourierOnline(ctx, courierId)
+	return err
+}
+
+// GetCourierStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCourierStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCourierStats(ctx, courierId)
+	return err
+}
+
+// AddStoragePlace converts echo context to params.
+func (w *ServerInterfaceWrapper) AddStoragePlace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddStoragePlace(ctx, courierId)
+	return err
+}
+
+// RemoveStoragePlace converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveStoragePlace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Path parameter "storagePlaceId" -------------
+	var storagePlaceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "storagePlaceId", ctx.Param("storagePlaceId"), &storagePlaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter storagePlaceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveStoragePlace(ctx, courierId, storagePlaceId)
+	return err
+}
+
 // CreateOrder converts echo context to params.
 func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
 	var err error
@@ -125,6 +599,86 @@ func (w *ServerInterfaceWrapper) GetOrders(ctx echo.Context) error {
 	return err
 }
 
+// CancelOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelOrder(ctx, orderId)
+	return err
+}
+
+// ConfirmDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmDelivery(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmDelivery(ctx, orderId)
+	return err
+}
+
+// FailDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) FailDelivery(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FailDelivery(ctx, orderId)
+	return err
+}
+
+// RateDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) RateDelivery(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RateDelivery(ctx, orderId)
+	return err
+}
+
+// ReassignOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) ReassignOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReassignOrder(ctx, orderId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -153,99 +707,496 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/api/v1/admin/dead-letters", wrapper.GetDeadLetters)
+	router.GET(baseURL+"/api/v1/admin/dead-letters/:deadLetterId", wrapper.GetDeadLetter)
+	router.POST(baseURL+"/api/v1/admin/dead-letters/:deadLetterId/replay", wrapper.ReplayDeadLetter)
 	router.GET(baseURL+"/api/v1/couriers", wrapper.GetCouriers)
 	router.POST(baseURL+"/api/v1/couriers", wrapper.CreateCourier)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/break", wrapper.StartCourierBreak)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/offline", wrapper.SetCourierOffline)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/online", wrapper.SetCourierOnline)
+	router.GET(baseURL+"/api/v1/couriers/:courierId/stats", wrapper.GetCourierStats)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/storage-places", wrapper.AddStoragePlace)
+	router.DELETE(baseURL+"/api/v1/couriers/:courierId/storage-places/:storagePlaceId", wrapper.RemoveStoragePlace)
 	router.POST(baseURL+"/api/v1/orders", wrapper.CreateOrder)
 	router.GET(baseURL+"/api/v1/orders/active", wrapper.GetOrders)
+	router.POST(baseURL+"/api/v1/orders/:orderId/cancel", wrapper.CancelOrder)
+	router.POST(baseURL+"/api/v1/orders/:orderId/confirm", wrapper.ConfirmDelivery)
+	router.POST(baseURL+"/api/v1/orders/:orderId/fail", wrapper.FailDelivery)
+	router.POST(baseURL+"/api/v1/orders/:orderId/rating", wrapper.RateDelivery)
+	router.POST(baseURL+"/api/v1/orders/:orderId/reassign", wrapper.ReassignOrder)
 
 }
 
-type GetCouriersRequestObject struct {
+type GetDeadLettersRequestObject struct {
 }
 
-type GetCouriersResponseObject interface {
-	VisitGetCouriersResponse(w http.ResponseWriter) error
+type GetDeadLettersResponseObject interface {
+	VisitGetDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type GetCouriers200JSONResponse []Courier
+type GetDeadLetters200JSONResponse []DeadLetter
 
-func (response GetCouriers200JSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+func (response GetDeadLetters200JSONResponse) VisitGetDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCouriersdefaultJSONResponse struct {
+type GetDeadLettersdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response GetCouriersdefaultJSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+func (response GetDeadLettersdefaultJSONResponse) VisitGetDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateCourierRequestObject struct {
-	Body *CreateCourierJSONRequestBody
-}
-
-type CreateCourierResponseObject interface {
-	VisitCreateCourierResponse(w http.ResponseWriter) error
-}
-
-type CreateCourier201Response struct {
+type GetDeadLetterRequestObject struct {
+	DeadLetterId openapi_types.UUID `json:"deadLetterId"`
 }
 
-func (response CreateCourier201Response) VisitCreateCourierResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+type GetDeadLetterResponseObject interface {
+	VisitGetDeadLetterResponse(w http.ResponseWriter) error
 }
 
-type CreateCourier400JSONResponse Error
+type GetDeadLetter200JSONResponse DeadLetter
 
-func (response CreateCourier400JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+func (response GetDeadLetter200JSONResponse) VisitGetDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCourier409JSONResponse Error
+type GetDeadLetter404JSONResponse Error
 
-func (response CreateCourier409JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+func (response GetDeadLetter404JSONResponse) VisitGetDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCourierdefaultJSONResponse struct {
+type GetDeadLetterdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response CreateCourierdefaultJSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+func (response GetDeadLetterdefaultJSONResponse) VisitGetDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateOrderRequestObject struct {
+type ReplayDeadLetterRequestObject struct {
+	DeadLetterId openapi_types.UUID `json:"deadLetterId"`
 }
 
-type CreateOrderResponseObject interface {
-	VisitCreateOrderResponse(w http.ResponseWriter) error
+type ReplayDeadLetterResponseObject interface {
+	VisitReplayDeadLetterResponse(w http.ResponseWriter) error
 }
 
-type CreateOrder201Response struct {
+type ReplayDeadLetter200Response struct {
 }
 
-func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
+func (response ReplayDeadLetter200Response) VisitReplayDeadLetterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ReplayDeadLetter404JSONResponse Error
+
+func (response ReplayDeadLetter404JSONResponse) VisitReplayDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayDeadLetter409JSONResponse Error
+
+func (response ReplayDeadLetter409JSONResponse) VisitReplayDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayDeadLetterdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ReplayDeadLetterdefaultJSONResponse) VisitReplayDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCouriersRequestObject struct {
+}
+
+type GetCouriersResponseObject interface {
+	VisitGetCouriersResponse(w http.ResponseWriter) error
+}
+
+type GetCouriers200JSONResponse []Courier
+
+func (response GetCouriers200JSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCouriersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetCouriersdefaultJSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCourierRequestObject struct {
+	Body *CreateCourierJSONRequestBody
+}
+
+type CreateCourierResponseObject interface {
+	VisitCreateCourierResponse(w http.ResponseWriter) error
+}
+
+type CreateCourier201Response struct {
+}
+
+func (response CreateCourier201Response) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type CreateCourier400JSONResponse Error
+
+func (response CreateCourier400JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCourier409JSONResponse Error
+
+func (response CreateCourier409JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCourierdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateCourierdefaultJSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StartCourierBreakRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type StartCourierBreakResponseObject interface {
+	VisitStartCourierBreakResponse(w http.ResponseWriter) error
+}
+
+type StartCourierBreak200Response struct {
+}
+
+func (response StartCourierBreak200Response) VisitStartCourierBreakResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type StartCourierBreak404JSONResponse Error
+
+func (response StartCourierBreak404JSONResponse) VisitStartCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCourierBreak409JSONResponse Error
+
+func (response StartCourierBreak409JSONResponse) VisitStartCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCourierBreakdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StartCourierBreakdefaultJSONResponse) VisitStartCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetCourierOfflineRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type SetCourierOfflineResponseObject interface {
+	VisitSetCourierOfflineResponse(w http.ResponseWriter) error
+}
+
+type SetCourierOffline200Response struct {
+}
+
+func (response SetCourierOffline200Response) VisitSetCourierOfflineResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type SetCourierOffline404JSONResponse Error
+
+func (response SetCourierOffline404JSONResponse) VisitSetCourierOfflineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierOffline409JSONResponse Error
+
+func (response SetCourierOffline409JSONResponse) VisitSetCourierOfflineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierOfflinedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetCourierOfflinedefaultJSONResponse) VisitSetCourierOfflineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetCourierOnlineRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type SetCourierOnlineResponseObject interface {
+	VisitSetCourierOnlineResponse(w http.ResponseWriter) error
+}
+
+type SetCourierOnline200Response struct {
+}
+
+func (response SetCourierOnline200Response) VisitSetCourierOnlineResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type SetCourierOnline404JSONResponse Error
+
+func (response SetCourierOnline404JSONResponse) VisitSetCourierOnlineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierOnline409JSONResponse Error
+
+func (response SetCourierOnline409JSONResponse) VisitSetCourierOnlineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierOnlinedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetCourierOnlinedefaultJSONResponse) VisitSetCourierOnlineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCourierStatsRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type GetCourierStatsResponseObject interface {
+	VisitGetCourierStatsResponse(w http.ResponseWriter) error
+}
+
+type GetCourierStats200JSONResponse CourierStats
+
+func (response GetCourierStats200JSONResponse) VisitGetCourierStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierStats404JSONResponse Error
+
+func (response GetCourierStats404JSONResponse) VisitGetCourierStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierStatsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetCourierStatsdefaultJSONResponse) VisitGetCourierStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddStoragePlaceRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *AddStoragePlaceJSONRequestBody
+}
+
+type AddStoragePlaceResponseObject interface {
+	VisitAddStoragePlaceResponse(w http.ResponseWriter) error
+}
+
+type AddStoragePlace200Response struct {
+}
+
+func (response AddStoragePlace200Response) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AddStoragePlace400JSONResponse Error
+
+func (response AddStoragePlace400JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlace404JSONResponse Error
+
+func (response AddStoragePlace404JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlacedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AddStoragePlacedefaultJSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RemoveStoragePlaceRequestObject struct {
+	CourierId      openapi_types.UUID `json:"courierId"`
+	StoragePlaceId openapi_types.UUID `json:"storagePlaceId"`
+}
+
+type RemoveStoragePlaceResponseObject interface {
+	VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error
+}
+
+type RemoveStoragePlace200Response struct {
+}
+
+func (response RemoveStoragePlace200Response) VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type RemoveStoragePlace404JSONResponse Error
+
+func (response RemoveStoragePlace404JSONResponse) VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveStoragePlace409JSONResponse Error
+
+func (response RemoveStoragePlace409JSONResponse) VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveStoragePlacedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RemoveStoragePlacedefaultJSONResponse) VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOrderRequestObject struct {
+	Body *CreateOrderJSONRequestBody
+}
+
+type CreateOrderResponseObject interface {
+	VisitCreateOrderResponse(w http.ResponseWriter) error
+}
+
+type CreateOrder201Response struct {
+}
+
+func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
 	return nil
 }
 
@@ -289,32 +1240,417 @@ func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type CancelOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type CancelOrderResponseObject interface {
+	VisitCancelOrderResponse(w http.ResponseWriter) error
+}
+
+type CancelOrder200Response struct {
+}
+
+func (response CancelOrder200Response) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CancelOrder404JSONResponse Error
+
+func (response CancelOrder404JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder409JSONResponse Error
+
+func (response CancelOrder409JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CancelOrderdefaultJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ConfirmDeliveryRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *ConfirmDeliveryJSONRequestBody
+}
+
+type ConfirmDeliveryResponseObject interface {
+	VisitConfirmDeliveryResponse(w http.ResponseWriter) error
+}
+
+type ConfirmDelivery200Response struct {
+}
+
+func (response ConfirmDelivery200Response) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ConfirmDelivery400JSONResponse Error
+
+func (response ConfirmDelivery400JSONResponse) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDelivery404JSONResponse Error
+
+func (response ConfirmDelivery404JSONResponse) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDelivery409JSONResponse Error
+
+func (response ConfirmDelivery409JSONResponse) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDeliverydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ConfirmDeliverydefaultJSONResponse) VisitConfirmDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FailDeliveryRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *FailDeliveryJSONRequestBody
+}
+
+type FailDeliveryResponseObject interface {
+	VisitFailDeliveryResponse(w http.ResponseWriter) error
+}
+
+type FailDelivery200Response struct {
+}
+
+func (response FailDelivery200Response) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FailDelivery400JSONResponse Error
+
+func (response FailDelivery400JSONResponse) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailDelivery404JSONResponse Error
+
+func (response FailDelivery404JSONResponse) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailDelivery409JSONResponse Error
+
+func (response FailDelivery409JSONResponse) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailDeliverydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response FailDeliverydefaultJSONResponse) VisitFailDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RateDeliveryRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *RateDeliveryJSONRequestBody
+}
+
+type RateDeliveryResponseObject interface {
+	VisitRateDeliveryResponse(w http.ResponseWriter) error
+}
+
+type RateDelivery200Response struct {
+}
+
+func (response RateDelivery200Response) VisitRateDeliveryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type RateDelivery400JSONResponse Error
+
+func (response RateDelivery400JSONResponse) VisitRateDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateDelivery404JSONResponse Error
+
+func (response RateDelivery404JSONResponse) VisitRateDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateDelivery409JSONResponse Error
+
+func (response RateDelivery409JSONResponse) VisitRateDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateDeliverydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RateDeliverydefaultJSONResponse) VisitRateDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReassignOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *ReassignOrderJSONRequestBody
+}
+
+type ReassignOrderResponseObject interface {
+	VisitReassignOrderResponse(w http.ResponseWriter) error
+}
+
+type ReassignOrder200Response struct {
+}
+
+func (response ReassignOrder200Response) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ReassignOrder400JSONResponse Error
+
+func (response ReassignOrder400JSONResponse) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignOrder404JSONResponse Error
+
+func (response ReassignOrder404JSONResponse) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignOrder409JSONResponse Error
+
+func (response ReassignOrder409JSONResponse) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ReassignOrderdefaultJSONResponse) VisitReassignOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Получить необработанные входящие сообщения
+	// (GET /api/v1/admin/dead-letters)
+	GetDeadLetters(ctx context.Context, request GetDeadLettersRequestObject) (GetDeadLettersResponseObject, error)
+	// Получить необработанное сообщение
+	// (GET /api/v1/admin/dead-letters/{deadLetterId})
+	GetDeadLetter(ctx context.Context, request GetDeadLetterRequestObject) (GetDeadLetterResponseObject, error)
+	// Повторно обработать сообщение
+	// (POST /api/v1/admin/dead-letters/{deadLetterId}/replay)
+	ReplayDeadLetter(ctx context.Context, request ReplayDeadLetterRequestObject) (ReplayDeadLetterResponseObject, error)
 	// Получить всех курьеров
 	// (GET /api/v1/couriers)
 	GetCouriers(ctx context.Context, request GetCouriersRequestObject) (GetCouriersResponseObject, error)
 	// Добавить курьера
 	// (POST /api/v1/couriers)
 	CreateCourier(ctx context.Context, request CreateCourierRequestObject) (CreateCourierResponseObject, error)
+	// Уйти на перерыв
+	// (POST /api/v1/couriers/{courierId}/break)
+	StartCourierBreak(ctx context.Context, request StartCourierBreakRequestObject) (StartCourierBreakResponseObject, error)
+	// Уйти с линии
+	// (POST /api/v1/couriers/{courierId}/offline)
+	SetCourierOffline(ctx context.Context, request SetCourierOfflineRequestObject) (SetCourierOfflineResponseObject, error)
+	// Выйти на линию
+	// (POST /api/v1/couriers/{courierId}/online)
+	SetCourierOnline(ctx context.Context, request SetCourierOnlineRequestObject) (SetCourierOnlineResponseObject, error)
+	// Получить статистику курьера
+	// (GET /api/v1/couriers/{courierId}/stats)
+	GetCourierStats(ctx context.Context, request GetCourierStatsRequestObject) (GetCourierStatsResponseObject, error)
+	// Добавить место хранения
+	// (POST /api/v1/couriers/{courierId}/storage-places)
+	AddStoragePlace(ctx context.Context, request AddStoragePlaceRequestObject) (AddStoragePlaceResponseObject, error)
+	// Снять место хранения
+	// (DELETE /api/v1/couriers/{courierId}/storage-places/{storagePlaceId})
+	RemoveStoragePlace(ctx context.Context, request RemoveStoragePlaceRequestObject) (RemoveStoragePlaceResponseObject, error)
 	// Создать заказ
 	// (POST /api/v1/orders)
 	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
 	// Получить все незавершенные заказы
 	// (GET /api/v1/orders/active)
 	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
+	// Отменить заказ
+	// (POST /api/v1/orders/{orderId}/cancel)
+	CancelOrder(ctx context.Context, request CancelOrderRequestObject) (CancelOrderResponseObject, error)
+	// Подтвердить доставку
+	// (POST /api/v1/orders/{orderId}/confirm)
+	ConfirmDelivery(ctx context.Context, request ConfirmDeliveryRequestObject) (ConfirmDeliveryResponseObject, error)
+	// Отметить неудачную доставку
+	// (POST /api/v1/orders/{orderId}/fail)
+	FailDelivery(ctx context.Context, request FailDeliveryRequestObject) (FailDeliveryResponseObject, error)
+	// Оценить доставку
+	// (POST /api/v1/orders/{orderId}/rating)
+	RateDelivery(ctx context.Context, request RateDeliveryRequestObject) (RateDeliveryResponseObject, error)
+	// Переназначить заказ
+	// (POST /api/v1/orders/{orderId}/reassign)
+	ReassignOrder(ctx context.Context, request ReassignOrderRequestObject) (ReassignOrderResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetDeadLetters operation middleware
+func (sh *strictHandler) GetDeadLetters(ctx echo.Context) error {
+	var request GetDeadLettersRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDeadLetters(ctx.Request().Context(), request.(GetDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDeadLetters")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetDeadLettersResponseObject); ok {
+		return validResponse.VisitGetDeadLettersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+// GetDeadLetter operation middleware
+func (sh *strictHandler) GetDeadLetter(ctx echo.Context, deadLetterId openapi_types.UUID) error {
+	var request GetDeadLetterRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	request.DeadLetterId = deadLetterId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDeadLetter(ctx.Request().Context(), request.(GetDeadLetterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDeadLetter")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetDeadLetterResponseObject); ok {
+		return validResponse.VisitGetDeadLetterResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+// ReplayDeadLetter operation middleware
+func (sh *strictHandler) ReplayDeadLetter(ctx echo.Context, deadLetterId openapi_types.UUID) error {
+	var request ReplayDeadLetterRequestObject
+
+	request.DeadLetterId = deadLetterId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplayDeadLetter(ctx.Request().Context(), request.(ReplayDeadLetterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplayDeadLetter")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReplayDeadLetterResponseObject); ok {
+		return validResponse.VisitReplayDeadLetterResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
 // GetCouriers operation middleware
@@ -369,10 +1705,173 @@ func (sh *strictHandler) CreateCourier(ctx echo.Context) error {
 	return nil
 }
 
+// StartCourierBreak operation middleware
+func (sh *strictHandler) StartCourierBreak(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request StartCourierBreakRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StartCourierBreak(ctx.Request().Context(), request.(StartCourierBreakRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartCourierBreak")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StartCourierBreakResponseObject); ok {
+		return validResponse.VisitStartCourierBreakResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetCourierOffline operation middleware
+func (sh *strictHandler) SetCourierOffline(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request SetCourierOfflineRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetCourierOffline(ctx.Request().Context(), request.(SetCourierOfflineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetCourierOffline")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetCourierOfflineResponseObject); ok {
+		return validResponse.VisitSetCourierOfflineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetCourierOnline operation middleware
+func (sh *strictHandler) SetCourierOnline(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request SetCourierOnlineRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetCourierOnline(ctx.Request().Context(), request.(SetCourierOnlineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetCourierOnline")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetCourierOnlineResponseObject); ok {
+		return validResponse.VisitSetCourierOnlineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCourierStats operation middleware
+func (sh *strictHandler) GetCourierStats(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request GetCourierStatsRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCourierStats(ctx.Request().Context(), request.(GetCourierStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCourierStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCourierStatsResponseObject); ok {
+		return validResponse.VisitGetCourierStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddStoragePlace operation middleware
+func (sh *strictHandler) AddStoragePlace(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request AddStoragePlaceRequestObject
+
+	request.CourierId = courierId
+
+	var body AddStoragePlaceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddStoragePlace(ctx.Request().Context(), request.(AddStoragePlaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddStoragePlace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddStoragePlaceResponseObject); ok {
+		return validResponse.VisitAddStoragePlaceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RemoveStoragePlace operation middleware
+func (sh *strictHandler) RemoveStoragePlace(ctx echo.Context, courierId openapi_types.UUID, storagePlaceId openapi_types.UUID) error {
+	var request RemoveStoragePlaceRequestObject
+
+	request.CourierId = courierId
+	request.StoragePlaceId = storagePlaceId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveStoragePlace(ctx.Request().Context(), request.(RemoveStoragePlaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveStoragePlace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RemoveStoragePlaceResponseObject); ok {
+		return validResponse.VisitRemoveStoragePlaceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // CreateOrder operation middleware
 func (sh *strictHandler) CreateOrder(ctx echo.Context) error {
 	var request CreateOrderRequestObject
 
+	var body CreateOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.CreateOrder(ctx.Request().Context(), request.(CreateOrderRequestObject))
 	}
@@ -415,24 +1914,230 @@ func (sh *strictHandler) GetOrders(ctx echo.Context) error {
 	return nil
 }
 
+// CancelOrder operation middleware
+func (sh *strictHandler) CancelOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request CancelOrderRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelOrder(ctx.Request().Context(), request.(CancelOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelOrderResponseObject); ok {
+		return validResponse.VisitCancelOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ConfirmDelivery operation middleware
+func (sh *strictHandler) ConfirmDelivery(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request ConfirmDeliveryRequestObject
+
+	request.OrderId = orderId
+
+	var body ConfirmDeliveryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmDelivery(ctx.Request().Context(), request.(ConfirmDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmDelivery")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ConfirmDeliveryResponseObject); ok {
+		return validResponse.VisitConfirmDeliveryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// FailDelivery operation middleware
+func (sh *strictHandler) FailDelivery(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request FailDeliveryRequestObject
+
+	request.OrderId = orderId
+
+	var body FailDeliveryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.FailDelivery(ctx.Request().Context(), request.(FailDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FailDelivery")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(FailDeliveryResponseObject); ok {
+		return validResponse.VisitFailDeliveryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RateDelivery operation middleware
+func (sh *strictHandler) RateDelivery(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request RateDeliveryRequestObject
+
+	request.OrderId = orderId
+
+	var body RateDeliveryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RateDelivery(ctx.Request().Context(), request.(RateDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RateDelivery")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RateDeliveryResponseObject); ok {
+		return validResponse.VisitRateDeliveryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReassignOrder operation middleware
+func (sh *strictHandler) ReassignOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request ReassignOrderRequestObject
+
+	request.OrderId = orderId
+
+	var body ReassignOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReassignOrder(ctx.Request().Context(), request.(ReassignOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReassignOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReassignOrderResponseObject); ok {
+		return validResponse.VisitReassignOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xVT28bRRT/KqsHx1Vtt1zYIwEhpIgeuIAQh8V+caby7iyz47RWtFLsQBspUXPhgHoo",
-	"KnyBjcnKi0Pcr/DeN0IzEyduPHFcEVURF8u7O/Pm9++92YW2TDKZYqpziHYhb29jEtu/G7KvBCrzN1My",
-	"Q6UF2g+iY347mLeVyLSQKURAv9EpVXTOI6r5Z6ppSiWPaMZ7EMKWVEmsIYJ+X3QgBD3IECLItRJpF4oQ",
-	"erIdu0K78LHCLYjgo8YVsMYFqsbmfF0RQhon6MXxDx8vn1GEoPCnvlDYgeh7sDBshYXDf7jcJX98gm1t",
-	"TvlCKemRoC07vsNf0YxOA5rxAdV0QlOqF9mLVD96eAVNpBq7qMwpCeZ53PVV/IMqmvKQR9erruZn8V3V",
-	"9THbXND8XXLPlnF8a4qJVCT9BKKmj8JgedN3t2y6hvkZmCo+qF/j0xvDeEsMEpFuYtrV2xC1PMHLM0Rf",
-	"mt/Q1GSXZkZ6Plok0rqVyEWuXG0fn8eqc1/7ytcnKxrErBfplvQAf80jGlPFL6ikyuR3QmXA+/zCPU15",
-	"n/f4iCqjMo3DwPDkIb01n+2iParNHn5ONb80n60ZVNKYZjQN330z5X0jgNA9A++bp3G3iyr4HHtiB9UA",
-	"QthBlTtkrQfNB02jjswwjTMBETyyr0LIYr1trWjEmWjstBptFzv7rovaQ/N3mtHEQjrjY0ftrX0wTGsT",
-	"noDGPKSKf1kiDRaDsuJ+1YEIvkS9MT/RGJFnMs1dOB42m27wpBpTCyTOsp5wzjSe5M5kZ6gNk8Ykv833",
-	"eVcVl8bGSsUD5+s1on9emHNA53xIf5t55AwegV28Ffd7+r0grkLm5q4Px+vLMVjauOb9JInVYO7FesIX",
-	"IWQyX9PPU5rRiU3ZRdnFauWSiRsKY41zaV0/Ya4/k53BncmzMBF9Gr26AgjFUpBaHtqr3f3kPcP3n50N",
-	"aEwlnVFNp24CUO1wfPrBcfCha2g6N3OYaj4O6MSOpnMzsAI6oxn9ZSdzfW864dfVkTWr5yNOmsvIJmPt",
-	"juChfWWsscUnVBoQNAl4GPBzquiMj/hlwCOjkL26bNtR6QS8oWXctXgHcb0XFry5QSOP+I24rcUO3sEl",
-	"E9iUTqzzFe/xgc2s0ahagMCHvpvnsQvCh7h3nNP/61tnbSeKoij+DQAA//8JDQamhg0AAA==",
+	"H4sIAAAAAAAC/+xcb2/cRnr/KgTbFwlAaaVcgl5U9IWiOI3RnB1Ybpvg7hDQy5HMeJfcklzHgrCAdjeK",
+	"ncqQUNfAHYI7B25etC9Xq6VFr7SrrzDzjYrnmSE5JIf7x1J8sq1XprXkzDPPn9/zb2a29apbb7gOcQJf",
+	"X9nW/epdUjfxcc1tejbx4LHhuQ3iBTbBH8z7pl0z79g1O9iC/1vEr3p2I7BdR1/R6VM6Zm3WYV16Rkfi",
+	"+bFu6MRp1vWV3+s3nZrtEN3Qb25sxE/OJx4x7+l/NPRgq0H0Fd0PPNvZ1FuGbluKOf5MBzSkI9ahEfue",
+	"RnRIe6xDx2xHN/QN16ubgb6iN5u2pStGrLlVkw+0rf+9Rzb0Ff3vKikbKoIHlS/i91qG7ph1oqTjlB2o",
+	"5vDcZqD64E+0R8/oCe3REY3YDh3TPj6P2B59qdFT2mM77BHbYV3W0Q3dDkjdn0bnLZhrPXAbeiuhxPQ8",
+	"cwv+7weuZ26SL2tmlfgKgv5CQ5AQ7Wlsl+0gLSHSdjDr9OvSDCoKAs90/IbrBbfxh8mD3c683AJOkv9o",
+	"2h6xQHVQnigKSYpGViHzE6Y65d75llQDIEmo9npgBr5Kvwks6FNSs+8Tb+u2XSfrpOo6lop/z9kODekA",
+	"2RZqtI//PWUHGh2zjsbadEyP6UDI+0CjAzrGt1iXPYw5rdFj2gMdhn812ofPQjpkXTqCT9luqmG2E5BN",
+	"4sEiqnwR1+eyDw2GZTvsMQ1B2rOYi8X5QKybnkU8v9ziaY/26QkuaiwvaUz7ygVYth+YTlVlJj+jabzk",
+	"q4DxaKgBvayNE43ZAXAOGa6xR7RHj0rZ5JkBLGSC5NgBF9cPONkQRDCkJzQSHBzTvoHSZG2wSiSgz7o0",
+	"ZB2NntGx+EJmqwbqkA4ZwWgyqy23eadGUnKdZv2OTO2a23QCBck/0TEMBZrDyaDjdJYxHSoYkLOgVGmK",
+	"gpUkYkwygiyVKvv6lJjWFyQIlN4jCEi9EfizLg84fMb2UBBDjY7pIcLUIUiEDpGvCtvwiBkQa1XFxCep",
+	"iZ6huEJ6SkP2Y2qNfc0ipqXVcAV+RnBmQBYCu05UhkI8z/UUE/4PGnMb1GXMHtGIHmbpzo3wL7ZjKUeJ",
+	"6NnUES7eW9aJ75ubZF6Y6bNdOqYDdoCMPaJjjoUgvh8lD1OYrmFu1VxTNdlzGA8F9oJG9JRjwkxjeqRR",
+	"M7emqgPrsjaqxCO0ppdc9/p8RfGfVPo3m3r4btNTgt2fBaY9hBXQoWJR2nvwAj2Dn98vjq3ykqnYkpll",
+	"/RLPupHao2w1aqPmULDmOhs2LFiEUFnzrroWKTHtAWfoAC2by3EQr9AAzB1zVvNQSIJgmSM9jrsS3LIu",
+	"rOaBWW/UgOClDz/4h6ksQionLfIz0641PVJcn0dMf3roKD6/xV/Ozy7GmDT/rcRrZaf3q66n4u+zMu/V",
+	"44HIMo88PgLNMB/YdQjFPzL0uu3w5+WpnoPPrKL5Wox782hCFscSC7Kd4DcfKCFdKPQ58FWlA+m4qpVl",
+	"xagOU8BdRXQknD7rYsT2MIaLgRQaDWm0qN1wA2FAxNKSnyKMKw40+A+a+ikiqXCF8CU3EHoEw2eNg8ca",
+	"Iwy1IJk40YR5jWJDQoYfC1WAH+O4iu3RkBsl97BDGmk8WpEi2UUpd1tr+oFbJ94NN/jcRZhbtSyP+P4N",
+	"N/jMbSKw3CIbTZ/A083gLgGIkdeszPG+kDIyhboA/A44k7lG9zUMK3HhBQ7T/xZ5TMR+YHviTf7QQ+Hs",
+	"x8w+A/FhXImsAyzpsD2RkUU00t5bvXVt9ZvfXb/xzVeGljx/HT+vfpX+ffWrb75+34BJRij/JJiAkV7Q",
+	"UFuIae4hxzXWRR92wh4KYvf/Uau4DeKYDXvxW991uLQGAvHY9xBPZ3UCln8kL3ZRo080ekRDOuY/sO+l",
+	"91EhY7490GikbWkLXJdCFH4PEw/WEfrW02pmAK/VXEdb0GIfxZVmmJcLcLhHB6A1rE17oDVZPKiZKvf7",
+	"fzwNBgEaXESgjicQldMwFlR/xkXllUF7b+3mzVufXr+xevvaN+tfr9++9rt/2iTu+8pYPMHFj5ckYFz4",
+	"eClR2DRMryl19SlSfnS5VrP828xyln+rWs+D4mq+ygyyNNlXGLqiEvT1PCPkwPmBDkOqMPkG+a60MjWl",
+	"SlO3nS+IsxnclSmQArQGIerAc4iqnpayJrPiAgseotbBKSvhBmZvRV7cNR2rJmKISQTg55/HL0MEblfv",
+	"NRvrgUeIymB/wRTtB1Rw8EZhJhjjSn+IRi1CtaQQYJQBH4BLG3GoRweZYA58O5pAXCvjUXKvJHfwbNcT",
+	"RcmpS/4yfhk/dOu2T6xPtlShFT3E9FAq00nul3XBi+/w5DRnsDOnBt8Re/OuMjmB5RfKQwL0TzFK2DW0",
+	"JYDxEQ1pJPwY0BDSkczK5Y+WZAtcUlqgSr0y5b2Clt0TyeqsBUJMPlqIC/9etuinGI90udxxjQc8vjmC",
+	"YJ8e0+EENhzSkB5rMbiifj2UcsNJDCit8v41ia2w6DQdSQI3MGv/5taayuGe0UP2nxBb6XNBosCCezx9",
+	"k6dQAUMJKmyYdg0yvNIazP/SiLXpCR3nwlm2x3YLxZhcHVMZt2/kY+g58qbLUv3nkDjPF35gBk1/JiBa",
+	"568qs/iE2FIRfy7BvEU2zGYNFr9av2MTJ9CNPOt+4cLFlOOgUPIvIg3YYQej6mdsFwH6BQ9JRQEGS2dY",
+	"voiTeyz7QEIDkSMd0JPssFhNZR1MLg5Zl+2zH2nEdiG6hJwFRo3wtRN2wH6AKNzQ6Anbp4f5+RaS0aEy",
+	"sBcrqZyupGy47vjNGhQ3eILi2ZvEw/+q0pGsf8gwdj0wHcv0LN1QlZOl+DhZMdvj4BUnBmn+wXnPHrNH",
+	"NMwtQlqDNOO1Bw3ItcppXk+0rkBcB2P0bs6byKkdL/5ASuf79qaDj1/a1XvE+tcG/PU70+ZVX6n8A60U",
+	"8C/8wzWoHddq+PwZAg1yO2h6DrFuu+vEAUxSUQ8GD5OWoNYrtjpGPGTA6uO8fQ+VN0wbbaV+UNHuw3oh",
+	"BI4S57FvwfOpIW8b7QmgTRPwuDg9iL/ORB2S5L7k6GTon3puw93YUHL4VWDP9az5mZ7VrulcljEvnnAK",
+	"8E0OSS7eZbxpQc45ApvC2t1qtdmwiVUazYCKQwutw0PjsRTcFOkQAvZLxkkAs58CfTixMT1VdoVu9Myh",
+	"2ZRwTO5FK2KyAuemKXJJ2+cJjegg5UaxTQ+9SXomkCIEhygq5LQX14iOc6yFb0819LUveLMWaoLHoInn",
+	"d56389mvckEYAYDiQMcFav68YqJcySBrKhA08HxRSsox2BiJvsxOwi+V7iSwSSwCZJvgxj6xq1tVLJys",
+	"V103wLLlmqlyVqAHtrPhKovwvLPxUEp9NR4g53oWoqkM7SzecurgSzsY+/Qg7mH72XRyTIdG9i9DdAKB",
+	"HWCmvP6dublJPC1uIOiGfp94PqdseXFpcQntj9cX9RX9N/gn6LcFd9GSKmbDrtxfrphW3XYq0ABdiBug",
+	"K9v6prIW8DPubujzaC1tiJ/gmiOUS7GZZaj6riJqKnReAdjREYAb0v+ZBGlrGX72iN9wHZ8D/wdLSzxY",
+	"cALC2+dmo1GzuSOpfCvSDw7Y8DTT7pZ0viKatFrK+Fq0EAUeCp3o8K0UIo6cg8pJxPHOi4qOZ0kjpIfI",
+	"5TfrddPbioUmSwiNI9vW7EkSkfq4kbrjCuOXa09l20pYeN1qXagyIYGyp2hrcrNZ6hazPXo6WZ3QFjyz",
+	"TrjK/36OmEfZhbbhI7Cu2E2s6DIjdNmbBF6TGJLMp0VNfzyn5s+q8HMr+IdLH74G5X5e1IKkBxbvGRq/",
+	"cfam3NBAw/nMq8L3OmBU7PpzmJm0zSG/yaFXansRa4t8H5ThNPchrBh7Gqe8XXSK6xywvYIh3kKi3ypb",
+	"fJPN5sOlj/925PCSIxSpWBusRaGLl8q0X8FwMiYtahvni7JoHzu3u4UgU+Xz1uIZX0f8JCZ7a4OnUsa3",
+	"jDkQGFKcw2QnyONitSorRF6ri1nL0Yv4wSeutXVh7JH6rCoe/ZQSqLcKirT8ChC49Holq/HNMpCN8oyL",
+	"Rq8P+7J0sL14m0BaiD/EVHDE+38nWMSMsKd4WSzh6WSVVUFcZTsp5LYqd/DER3mc8pPcVBYJo8h9cxDY",
+	"i8OqMbIyW/AwNI7McuYcmx3r4s4c2qfHooAVFkxtPTC9GDH5IZVXj00KVq2IS+QN2u9CUJIRcz4cuTLI",
+	"OQzyF/oSdE6Lt5hxi4HdtP3p1uiKs1gXaI9ZI8wV8SBF7+AXjyE3SEramUYhXwrWw4fifEfOOJNgJj1L",
+	"dmWcV8Z5eY2T0xfx/aUzmKUzh1Wytuh49jLWyM1T7R0nWZRzZVBXBnVpDeoJyFDyd8Ko2P50o/LjY5/q",
+	"dLt4mnGkiirPZjyiaPD9geozovkdvjRKz/OpDiJOSOf5YdY32FwvRJcyzLis9eupKHApSx1cUVFzeBA3",
+	"ZN25sz5xHH2hkZxHL3FsT/iOGMVpLxFLCsSKcKsrhJGj/AYxRacc+IynPvC1HQ0z2CPc1cbP3onNN1jV",
+	"PuE72cTgER0WrG/VsjJ7Ud4Y6/tVykUZVrRarTyVrQtx05elTHQFFKWVoHITnBcgKtvy/RWifWwR2HBY",
+	"tttOOnpQwKdJpC1q6VamApK0+d/j7h0gwjE/GZdvZNXd++SNwwRjHqpOJ94YUiQxK8F3MdDnPmWC7hWN",
+	"m+29vmzgLxPoOk5N4tLgzfPUGGdEGje5uWTmlkx6c0t+6y5raxihn7DHbJ/vW5dPKPUSW1D1bPgG51/N",
+	"BfPhW8L5nrMlc0mErRaDQr4VsxrY98kFNFI5yh+jT4OY81FJGqhKx5LLVH793qqQ9tvcWZ1ZEgp12Ba7",
+	"jVuVKh5MmMv+kUOnAk2SNn58lZPgogB2+XhHmP6a6moBC5CeGAteOT7IbrVXuN50P/274HOTHeRXpbVz",
+	"WeKznOZPBl3JyvjJoAlm9ifJjhWng8e4V5Nf0QC7TV9OvjAmxLNsUeE2jIkXYSxq9K80VPzAzyWH4kAW",
+	"pw7BSJyGTAp1wDi2K26QkM9DFtMBcVZK2pP9Btj6xQcmypuDrgoEbwvkqe3JKF4sw9rilMMZZhV7iQM9",
+	"zi0jAYI0SFOCwKUKXlIKBzFw5o9rTIRPOCg9a/99xLcKwwmeo/QYdh6uF7UYy1kn/lE61A3lkfQMULYd",
+	"L981JE8MpdeQ7XJ0HfA3M5dNCYnmJDiccv3XopZd35i1MRo7xIHEMAC5eC1NJhlLe56AA2dsR97ik1y4",
+	"kpOEId3cOKKhUjcz2ss/QF8QglvgpwyQxmPgP+3Fd5PxKWVa4hL1ftFBwCnZK++QvXLtyjG8LY5BQUR6",
+	"PQDaiaJCKwAkgzoh+5H9l5ZcijQJgS5bFK1EXjrCQ4zz+Yf0ItsyDyGHwRzYeSbLL+kSF51J19IWndSJ",
+	"Mn9FP5I2pRNfw69Kk2C22CLMX2MVYfwcKo7kK4rpZkCuwDFzH+QVNr6t2FiwwqQVLTYpx1Z7mTp/z8qR",
+	"ZDqciSs/5qvKHWcv1CipvGU5l3yjgDalB1LcvTHAXahHcIot9wXr/sFJ7swUWVCXf9TX8IYPMRR7LFqM",
+	"pbQkm/ReTrtTDW8PwSvi8VTRy8xlIvwI/Qs6UuLsHxxF11K+feUdRdosE9R5rko6RrJ3OeG3fM1LWYKQ",
+	"1w39CsfPAaGqoPGqBnyuggbXTBlgFdXgVuv/BwBrQxO9QWYAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,3 +8,11 @@ type DomainEvent interface {
 	GetID() uuid.UUID
 	GetName() string
 }
+
+// SecretEvent carries a secret which must not be stored in plain text.
+// The secret is left out of the event payload and is kept encrypted next to it.
+type SecretEvent interface {
+	DomainEvent
+	GetSecret() string
+	WithSecret(secret string) DomainEvent
+}
@@ -0,0 +1,62 @@
+package errs
+
+import (
+	"errors"
+)
+
+// Kinds of errors which survive serialization, e.g. when an error is stored in the DB.
+const (
+	KindUnknown           = "Unknown"
+	KindExpectationFailed = "ExpectationFailed"
+	KindObjectNotFound    = "ObjectNotFound"
+	KindValueIsInvalid    = "ValueIsInvalid"
+	KindValueIsOutOfRange = "ValueIsOutOfRange"
+	KindValueIsRequired   = "ValueIsRequired"
+	KindVersionIsInvalid  = "VersionIsInvalid"
+)
+
+var kinds = []struct {
+	kind     string
+	sentinel error
+}{
+	{KindExpectationFailed, ErrExpectationFailed},
+	{KindObjectNotFound, ErrObjectNotFound},
+	{KindValueIsInvalid, ErrValueIsInvalid},
+	{KindValueIsOutOfRange, ErrValueIsOutOfRange},
+	{KindValueIsRequired, ErrValueIsRequired},
+	{KindVersionIsInvalid, ErrVersionIsInvalid},
+}
+
+// Kind returns the kind of the error or KindUnknown.
+func Kind(err error) string {
+	for _, k := range kinds {
+		if errors.Is(err, k.sentinel) {
+			return k.kind
+		}
+	}
+	return KindUnknown
+}
+
+// RestoredError is an error restored from its kind and message.
+type RestoredError struct {
+	Kind    string
+	Message string
+}
+
+// Restore makes an error which matches the sentinel of the kind with errors.Is.
+func Restore(kind string, message string) *RestoredError {
+	return &RestoredError{Kind: kind, Message: message}
+}
+
+func (e *RestoredError) Error() string {
+	return e.Message
+}
+
+func (e *RestoredError) Unwrap() error {
+	for _, k := range kinds {
+		if k.kind == e.Kind {
+			return k.sentinel
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKindAndRestore(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		kind     string
+		sentinel error
+	}{
+		{name: "required", err: NewValueIsRequiredError("volume"), kind: KindValueIsRequired, sentinel: ErrValueIsRequired},
+		{name: "invalid", err: NewValueIsInvalidError("street"), kind: KindValueIsInvalid, sentinel: ErrValueIsInvalid},
+		{name: "not found wrapped", err: fmt.Errorf("wrap: %w", NewObjectNotFoundError("order", 1)), kind: KindObjectNotFound, sentinel: ErrObjectNotFound},
+		{name: "unknown", err: errors.New("boom"), kind: KindUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			kind := Kind(tt.err)
+			assert.Equal(tt.kind, kind)
+
+			restored := Restore(kind, tt.err.Error())
+			assert.Equal(tt.err.Error(), restored.Error())
+			if tt.sentinel != nil {
+				assert.ErrorIs(restored, tt.sentinel)
+			} else {
+				assert.Nil(restored.Unwrap())
+			}
+		})
+	}
+}